@@ -0,0 +1,5 @@
+data "sonarcloud_metrics" "all" {}
+
+output "gate_metrics" {
+  value = [for metric in data.sonarcloud_metrics.all.metrics : metric.key if metric.allowed_in_gates]
+}
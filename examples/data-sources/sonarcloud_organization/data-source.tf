@@ -0,0 +1,5 @@
+data "sonarcloud_organization" "org" {}
+
+output "subscription" {
+  value = data.sonarcloud_organization.org.subscription
+}
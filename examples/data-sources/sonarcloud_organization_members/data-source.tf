@@ -0,0 +1 @@
+data "sonarcloud_organization_members" "members" {}
@@ -0,0 +1,10 @@
+data "sonarcloud_project_badge" "example" {
+  project = sonarcloud_project.example.key
+  branch  = "main"
+  metrics = ["alert_status", "coverage", "bugs"]
+}
+
+# The badge urls contain the token and are therefore sensitive
+output "quality_gate_badge" {
+  value = nonsensitive("![Quality Gate Status](${data.sonarcloud_project_badge.example.badges["alert_status"]})")
+}
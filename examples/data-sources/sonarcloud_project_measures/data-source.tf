@@ -0,0 +1,17 @@
+data "sonarcloud_project_measures" "example" {
+  project     = sonarcloud_project.example.key
+  metric_keys = ["coverage", "security_rating", "reliability_rating"]
+}
+
+resource "terraform_data" "tier_1" {
+  lifecycle {
+    precondition {
+      condition     = tonumber(lookup(data.sonarcloud_project_measures.example.measures, "coverage", "0")) >= 80
+      error_message = "Tier-1 services require a coverage of at least 80%."
+    }
+    precondition {
+      condition     = tonumber(data.sonarcloud_project_measures.example.measures["security_rating"]) <= 1
+      error_message = "Tier-1 services require an A security rating."
+    }
+  }
+}
@@ -1,3 +1,7 @@
 data "sonarcloud_quality_gate" "awesome" {
   name = "my_awesome_quality_gate"
-}
\ No newline at end of file
+}
+
+data "sonarcloud_quality_gate" "by_id" {
+  gate_id = 12345
+}
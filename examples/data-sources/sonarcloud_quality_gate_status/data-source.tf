@@ -0,0 +1,13 @@
+data "sonarcloud_quality_gate_status" "example" {
+  project = sonarcloud_project.example.key
+  branch  = "main"
+}
+
+resource "terraform_data" "rollout" {
+  lifecycle {
+    precondition {
+      condition     = data.sonarcloud_quality_gate_status.example.passed
+      error_message = "The main branch fails its quality gate: ${join(", ", [for c in data.sonarcloud_quality_gate_status.example.conditions : "${c.metric} is ${c.actual_value} (threshold ${c.op} ${c.error})" if c.status == "ERROR"])}."
+    }
+  }
+}
@@ -1 +1,11 @@
-data "sonarcloud_quality_gates" "all" {}
\ No newline at end of file
+data "sonarcloud_quality_gates" "all" {
+  include_details = true
+}
+
+// Report all quality gates that are not compliant with Clean as You Code
+output "non_cayc_compliant_gates" {
+  value = {
+    for gate in data.sonarcloud_quality_gates.all.quality_gates : gate.name => gate.project_keys
+    if gate.cayc_status == "non-compliant"
+  }
+}
@@ -0,0 +1,11 @@
+data "sonarcloud_user_tokens" "tokens" {
+  login = var.token_owner
+}
+
+# Tokens that have not been used for 90 days
+locals {
+  stale_tokens = [
+    for token in data.sonarcloud_user_tokens.tokens.tokens : token.name
+    if timecmp(coalesce(token.last_connection_date, token.created_at), timeadd(plantimestamp(), "-2160h")) < 0
+  ]
+}
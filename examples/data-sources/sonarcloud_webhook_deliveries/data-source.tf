@@ -0,0 +1,8 @@
+data "sonarcloud_webhook_deliveries" "example" {
+  webhook     = sonarcloud_webhook.example.key
+  max_results = 20
+}
+
+output "failed_deliveries" {
+  value = [for delivery in data.sonarcloud_webhook_deliveries.example.deliveries : delivery.id if !delivery.success]
+}
@@ -0,0 +1,10 @@
+ephemeral "sonarcloud_user_token" "ci" {
+  login = "ci-user@github"
+}
+
+# Write-only attributes accept ephemeral values, so the token never ends up in the state
+resource "aws_secretsmanager_secret_version" "sonar_token" {
+  secret_id                = aws_secretsmanager_secret.sonar_token.id
+  secret_string_wo         = ephemeral.sonarcloud_user_token.ci.token
+  secret_string_wo_version = 1
+}
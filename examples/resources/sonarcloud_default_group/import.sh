@@ -0,0 +1,2 @@
+# import the default group using the <organization> key
+terraform import "sonarcloud_default_group.default" "my-organization"
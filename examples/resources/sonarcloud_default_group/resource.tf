@@ -0,0 +1,8 @@
+resource "sonarcloud_user_group" "newcomers" {
+  name        = "Newcomers"
+  description = "All new members of the organization."
+}
+
+resource "sonarcloud_default_group" "default" {
+  group = sonarcloud_user_group.newcomers.name
+}
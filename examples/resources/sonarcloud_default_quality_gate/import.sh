@@ -0,0 +1,2 @@
+# import the default quality gate using the <organization> key
+terraform import "sonarcloud_default_quality_gate.default" "my-organization"
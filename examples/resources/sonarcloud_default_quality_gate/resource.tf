@@ -0,0 +1,14 @@
+resource "sonarcloud_quality_gate" "strict" {
+  name = "Strict"
+  conditions = [
+    {
+      metric = "new_coverage"
+      error  = 80
+      op     = "LT"
+    }
+  ]
+}
+
+resource "sonarcloud_default_quality_gate" "default" {
+  gate_id = sonarcloud_quality_gate.strict.gate_id
+}
@@ -0,0 +1,2 @@
+# import the settings of the configured organization using the <organization> key
+terraform import "sonarcloud_organization.org" "my-organization"
@@ -0,0 +1,6 @@
+resource "sonarcloud_organization" "org" {
+  name               = "My Organization"
+  description        = "All projects of My Organization"
+  url                = "https://www.example.com"
+  default_visibility = "private"
+}
@@ -0,0 +1,2 @@
+# import an organization member by using <login>
+terraform import "sonarcloud_organization_member.example_member" "user@github"
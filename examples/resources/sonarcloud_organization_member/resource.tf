@@ -0,0 +1,8 @@
+resource "sonarcloud_organization_member" "example_member" {
+  login = var.example_member_login
+}
+
+resource "sonarcloud_user_group_member" "example_member" {
+  group = "Developers"
+  login = sonarcloud_organization_member.example_member.login
+}
@@ -0,0 +1,14 @@
+# Renews the badge token whenever the rotation value changes
+resource "sonarcloud_project_badge_token" "example" {
+  project = sonarcloud_project.example.key
+
+  triggers = {
+    rotation = "2024-01"
+  }
+}
+
+# Referencing the resource reads the badge urls after the token is renewed
+data "sonarcloud_project_badge" "example" {
+  project = sonarcloud_project_badge_token.example.project
+  metrics = ["alert_status", "coverage"]
+}
@@ -0,0 +1,2 @@
+# import the quality gate selection of a project using the <project key>
+terraform import "sonarcloud_project_quality_gate.awesome_project" "my-awesome-project"
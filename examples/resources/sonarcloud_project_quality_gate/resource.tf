@@ -0,0 +1,8 @@
+data "sonarcloud_quality_gate" "awesome_qg" {
+  name = "my_awesome_quality_gate"
+}
+
+resource "sonarcloud_project_quality_gate" "awesome_project" {
+  project_key = "my-awesome-project"
+  gate_id     = data.sonarcloud_quality_gate.awesome_qg.gate_id
+}
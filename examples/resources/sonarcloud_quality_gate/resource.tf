@@ -17,3 +17,16 @@ resource "sonarcloud_quality_gate" "awesome" {
   ]
 
 }
+
+// Start from the built-in gate and only tighten the coverage on new code
+resource "sonarcloud_quality_gate" "sonar_way_strict" {
+  name      = "Sonar way (strict)"
+  copy_from = "Sonar way"
+  conditions = [
+    {
+      metric = "new_coverage"
+      error  = 90
+      op     = "LT"
+    }
+  ]
+}
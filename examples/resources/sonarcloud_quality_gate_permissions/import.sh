@@ -0,0 +1,2 @@
+# import the permissions of a quality gate using the <quality gate id>
+terraform import "sonarcloud_quality_gate_permissions.team" "12345"
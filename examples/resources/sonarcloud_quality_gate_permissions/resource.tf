@@ -0,0 +1,10 @@
+resource "sonarcloud_quality_gate" "team" {
+  name = "Team A"
+}
+
+// Let the leads of team A edit their own quality gate
+resource "sonarcloud_quality_gate_permissions" "team" {
+  gate_id = sonarcloud_quality_gate.team.gate_id
+  groups  = ["team-a-leads"]
+  users   = ["jane-doe"]
+}
@@ -0,0 +1,2 @@
+# import all projects that are selected on a quality gate using the <quality gate id>
+terraform import "sonarcloud_quality_gate_selection.example_quality_gate_selection" "12345"
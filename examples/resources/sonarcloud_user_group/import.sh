@@ -1,2 +1,5 @@
 # import a user group using  <group_name>
 terraform import "sonarcloud_user_group.qa_team" "QA Team"
+
+# import a user group using its numeric <group_id>
+terraform import "sonarcloud_user_group.qa_team" "123456"
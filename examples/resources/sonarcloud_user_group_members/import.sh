@@ -0,0 +1,2 @@
+# import all members of a group by using <group_name>
+terraform import "sonarcloud_user_group_members.developers" "Developers"
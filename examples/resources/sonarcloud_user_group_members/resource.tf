@@ -0,0 +1,8 @@
+resource "sonarcloud_user_group" "developers" {
+  name = "Developers"
+}
+
+resource "sonarcloud_user_group_members" "developers" {
+  group  = sonarcloud_user_group.developers.name
+  logins = var.developer_logins
+}
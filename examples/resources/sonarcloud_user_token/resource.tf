@@ -2,3 +2,13 @@ resource "sonarcloud_user_token" "test_token" {
   name  = "EXAMPLE_TOKEN"
   login = var.token_owner
 }
+
+# A token for analyzing a single project, which is replaced a week before it expires
+resource "sonarcloud_user_token" "analysis_token" {
+  name          = "EXAMPLE_ANALYSIS_TOKEN"
+  login         = var.token_owner
+  type          = "PROJECT_ANALYSIS_TOKEN"
+  project_key   = sonarcloud_project.example.key
+  rotation_days = 90
+  rotate_before = 7
+}
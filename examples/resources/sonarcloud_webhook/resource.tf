@@ -0,0 +1,9 @@
+resource "sonarcloud_webhook" "example" {
+  name         = "example"
+  organization = var.organization
+  url          = "https://www.example.com/sonarcloud"
+
+  # The secret is never stored in the state, increase the version to update it
+  secret_wo         = var.webhook_secret
+  secret_wo_version = 1
+}
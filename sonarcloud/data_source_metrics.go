@@ -0,0 +1,131 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MetricsDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &MetricsDataSource{}
+}
+
+func (*MetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+func (d *MetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d MetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves all metrics that are available in SonarCloud.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"metrics": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The metrics.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the metric.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the metric.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the metric.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the values of the metric, e.g. `INT`, `PERCENT` or `RATING`.",
+						},
+						"domain": schema.StringAttribute{
+							Computed:    true,
+							Description: "The domain of the metric, e.g. `Coverage` or `Reliability`.",
+						},
+						"direction": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Whether higher values are better (`1`), worse (`-1`) or neither (`0`).",
+						},
+						"qualitative": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the metric describes the quality of the code.",
+						},
+						"hidden": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the metric is hidden.",
+						},
+						"allowed_in_gates": schema.BoolAttribute{
+							Computed: true,
+							Description: "Whether the metric can likely be used in the conditions of a quality gate. SonarCloud does not return this," +
+								" it is derived by the provider from the type of the metric and a list of metrics known to be excluded.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d MetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	response, err := d.p.metricsCatalog()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the metrics",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	allMetrics := make([]DataMetric, len(response.Metrics))
+	for i, metric := range response.Metrics {
+		allMetrics[i] = DataMetric{
+			Key:            types.StringValue(metric.Key),
+			Name:           types.StringValue(metric.Name),
+			Description:    types.StringValue(metric.Description),
+			Type:           types.StringValue(metric.Type),
+			Domain:         types.StringValue(metric.Domain),
+			Direction:      types.Int64Value(int64(metric.Direction)),
+			Qualitative:    types.BoolValue(metric.Qualitative),
+			Hidden:         types.BoolValue(metric.Hidden),
+			AllowedInGates: types.BoolValue(metricAllowedInGates(metric.Key, metric.Type, metric.Hidden)),
+		}
+	}
+
+	result := DataMetrics{
+		ID:      types.StringValue(d.p.organization),
+		Metrics: allMetrics,
+	}
+
+	diags := resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,37 @@
+package sonarcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceMetrics(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMetricsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_metrics.test", "metrics.*", map[string]string{
+						"key":              "coverage",
+						"type":             "PERCENT",
+						"direction":        "1",
+						"allowed_in_gates": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_metrics.test", "metrics.*", map[string]string{
+						"key":              "alert_status",
+						"allowed_in_gates": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMetricsConfig() string {
+	return `
+data "sonarcloud_metrics" "test" {}
+`
+}
@@ -0,0 +1,104 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+type OrganizationDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+func (*OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d OrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves the settings and the subscription plan of the configured organization." +
+			" The limits of the plan, such as the allowed lines of code, are not available through the API and are not part of this data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key of the organization.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The display name of the organization.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the organization.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the website of the organization.",
+			},
+			"avatar": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the avatar of the organization.",
+			},
+			"default_visibility": schema.StringAttribute{
+				Computed:    true,
+				Description: "The visibility of new projects, either `public` or `private`.",
+			},
+			"default_permission_template": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the permission template that grants the default permissions on new projects.",
+			},
+			"subscription": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subscription plan of the organization, e.g. `FREE` or `PAID`.",
+			},
+			"private_projects_allowed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the subscription plan allows private projects, only the `FREE` plan is limited to public projects. Not set if the plan is not known.",
+			},
+			"can_make_projects_private": schema.BoolAttribute{
+				Computed: true,
+				Description: "Whether the token of the provider may change the visibility of projects to private." +
+					" This depends on both the subscription plan and the permissions of the token.",
+			},
+		},
+	}
+}
+
+func (d OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	result, err := readOrganization(d.p)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization",
+			fmt.Sprintf("Reading the organization returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,142 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/organizations"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/user_groups"
+)
+
+type OrganizationMembersDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &OrganizationMembersDataSource{}
+}
+
+func (*OrganizationMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (d *OrganizationMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d OrganizationMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves all members of the configured organization and the groups they belong to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"members": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The members of this organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"login": schema.StringAttribute{
+							Computed:    true,
+							Description: "The login of this user.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of this user.",
+						},
+						"groups": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The names of the user groups this user is a member of.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d OrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var diags diag.Diagnostics
+
+	// An empty search request retrieves all members
+	request := organizations.SearchMembersRequest{
+		Organization: d.p.organization,
+	}
+
+	res, err := d.p.client.Organizations.SearchMembersAll(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read organization_members",
+			fmt.Sprintf("The SearchMembersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	// The members response only contains the number of groups, so we collect the memberships per group
+	groupsRequest := user_groups.SearchRequest{}
+
+	groups, err := d.p.client.UserGroups.SearchAll(groupsRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read user_groups",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	memberships := make(map[string][]attr.Value)
+	for _, group := range groups.Groups {
+		usersRequest := user_groups.UsersRequest{
+			Name: group.Name,
+		}
+
+		users, err := d.p.client.UserGroups.UsersAll(usersRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read user_group_members",
+				fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+			)
+			return
+		}
+
+		for _, user := range users.Users {
+			memberships[user.Login] = append(memberships[user.Login], types.StringValue(group.Name))
+		}
+	}
+
+	result := DataOrganizationMembers{}
+	allMembers := make([]DataOrganizationMember, len(res.Users))
+	for i, user := range res.Users {
+		allMembers[i] = DataOrganizationMember{
+			Login:  types.StringValue(user.Login),
+			Name:   types.StringValue(user.Name),
+			Groups: types.SetValueMust(types.StringType, memberships[user.Login]),
+		}
+	}
+	result.Members = allMembers
+	result.ID = types.StringValue(d.p.organization)
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,29 @@
+package sonarcloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceOrganizationMembers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrganizationMembersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization_members.test_members", "members.#"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization_members.test_members", "members.0.login"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization_members.test_members", "members.0.groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrganizationMembersConfig() string {
+	return `
+data "sonarcloud_organization_members" "test_members" {}
+`
+}
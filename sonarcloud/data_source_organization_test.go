@@ -0,0 +1,36 @@
+package sonarcloud
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOrganization(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOrganizationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_organization.test", "id", organization),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization.test", "name"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization.test", "default_visibility"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization.test", "subscription"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization.test", "private_projects_allowed"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_organization.test", "can_make_projects_private"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOrganizationConfig() string {
+	return `
+data "sonarcloud_organization" "test" {}
+`
+}
@@ -0,0 +1,161 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/project_badges"
+)
+
+// projectBadgeUrl is the endpoint that renders the badge of a single metric
+const projectBadgeUrl = "https://sonarcloud.io/api/project_badges/measure"
+
+// badgeMetrics are the metrics SonarCloud can render a badge for
+var badgeMetrics = []string{
+	"alert_status",
+	"bugs",
+	"code_smells",
+	"coverage",
+	"duplicated_lines_density",
+	"ncloc",
+	"reliability_rating",
+	"security_rating",
+	"sqale_index",
+	"sqale_rating",
+	"vulnerabilities",
+}
+
+type ProjectBadgeDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewProjectBadgeDataSource() datasource.DataSource {
+	return &ProjectBadgeDataSource{}
+}
+
+func (*ProjectBadgeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_badge"
+}
+
+func (d *ProjectBadgeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d ProjectBadgeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves the badge token of a project and the urls of its badges." +
+			" The token is required to show the badges of private projects. Use the `sonarcloud_project_badge_token` resource to renew the token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the project.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the branch to show in the badges. Defaults to the main branch.",
+			},
+			"metrics": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("The metrics to generate badge urls for. Defaults to `alert_status`, the quality gate status. Available metrics: %s.",
+					terraformListString(badgeMetrics)),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(badgeMetrics...)),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The badge token of the project.",
+			},
+			"badges": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The badge urls, keyed by metric. The urls contain the badge token.",
+			},
+		},
+	}
+}
+
+func (d ProjectBadgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataProjectBadge
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := project_badges.TokenRequest{
+		Project: config.Project.ValueString(),
+	}
+
+	response, err := d.p.client.ProjectBadges.Token(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project_badge token",
+			fmt.Sprintf("The Token request returned an error: %+v", err),
+		)
+		return
+	}
+
+	metrics := config.Metrics
+	if metrics.IsNull() || metrics.IsUnknown() {
+		metrics = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("alert_status")})
+	}
+
+	badges := make(map[string]attr.Value)
+	for _, elem := range metrics.Elements() {
+		metric := elem.(types.String).ValueString()
+		query := url.Values{}
+		query.Set("project", config.Project.ValueString())
+		query.Set("metric", metric)
+		if branch := config.Branch.ValueString(); branch != "" {
+			query.Set("branch", branch)
+		}
+		if response.Token != "" {
+			query.Set("token", response.Token)
+		}
+		badges[metric] = types.StringValue(projectBadgeUrl + "?" + query.Encode())
+	}
+
+	result := DataProjectBadge{
+		ID:      types.StringValue(fmt.Sprintf("%s-%s", config.Project.ValueString(), config.Branch.ValueString())),
+		Project: config.Project,
+		Branch:  config.Branch,
+		Metrics: metrics,
+		Token:   types.StringValue(response.Token),
+		Badges:  types.MapValueMust(types.StringType, badges),
+	}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
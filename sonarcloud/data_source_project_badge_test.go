@@ -0,0 +1,41 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProjectBadge(t *testing.T) {
+	project := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectBadgeConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarcloud_project_badge.test", "token"),
+					resource.TestCheckResourceAttr("data.sonarcloud_project_badge.test", "badges.%", "2"),
+					resource.TestMatchResourceAttr("data.sonarcloud_project_badge.test", "badges.coverage",
+						regexp.MustCompile(`^https://sonarcloud\.io/api/project_badges/measure\?.*metric=coverage`)),
+					resource.TestMatchResourceAttr("data.sonarcloud_project_badge.test", "badges.alert_status",
+						regexp.MustCompile(`token=`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectBadgeConfig(project string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_project_badge" "test" {
+  project = "%s"
+  metrics = ["alert_status", "coverage"]
+}
+`, project)
+}
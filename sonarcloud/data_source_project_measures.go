@@ -0,0 +1,136 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/measures"
+)
+
+type ProjectMeasuresDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewProjectMeasuresDataSource() datasource.DataSource {
+	return &ProjectMeasuresDataSource{}
+}
+
+func (*ProjectMeasuresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_measures"
+}
+
+func (d *ProjectMeasuresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d ProjectMeasuresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves the current measures of a project or one of its branches.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the project.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the branch. Defaults to the main branch.",
+			},
+			"metric_keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The keys of the metrics to retrieve, e.g. `coverage`, `ncloc` or `security_rating`." +
+					" Please query https://sonarcloud.io/api/metrics/search for an up-to-date list of metrics.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"measures": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The values of the measures, keyed by metric. Metrics on new code, e.g. `new_coverage`, have the value of the new code period." +
+					" Metrics without a value, e.g. coverage of a project without tests, are left out." +
+					" Ratings range from `1.0` (A) to `5.0` (E).",
+			},
+		},
+	}
+}
+
+func (d ProjectMeasuresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataProjectMeasures
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metricKeys := make([]string, 0, len(config.MetricKeys.Elements()))
+	for _, elem := range config.MetricKeys.Elements() {
+		metricKeys = append(metricKeys, elem.(types.String).ValueString())
+	}
+
+	// Fill in api action struct
+	request := measures.ComponentRequest{
+		Branch:     config.Branch.ValueString(),
+		Component:  config.Project.ValueString(),
+		MetricKeys: strings.Join(metricKeys, ","),
+	}
+
+	response, err := d.p.client.Measures.Component(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project_measures",
+			fmt.Sprintf("The Component request returned an error: %+v", err),
+		)
+		return
+	}
+
+	values := make(map[string]attr.Value)
+	for _, measure := range response.Component.Measures {
+		// Metrics on new code, e.g. new_coverage, only have a value for the new code period
+		value := measure.Value
+		if value == "" {
+			value = measure.Period.Value
+		}
+		if value == "" {
+			continue
+		}
+		values[measure.Metric] = types.StringValue(value)
+	}
+
+	result := DataProjectMeasures{
+		ID:         types.StringValue(fmt.Sprintf("%s-%s", config.Project.ValueString(), config.Branch.ValueString())),
+		Project:    config.Project,
+		Branch:     config.Branch,
+		MetricKeys: config.MetricKeys,
+		Measures:   types.MapValueMust(types.StringType, values),
+	}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,71 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceProjectMeasures(t *testing.T) {
+	project := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectMeasuresConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_project_measures.test", "project", project),
+					resource.TestCheckResourceAttr("data.sonarcloud_project_measures.test", "metric_keys.#", "2"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_project_measures.test", "measures.%"),
+				),
+			},
+			{
+				Config: testAccDataSourceProjectMeasuresNewCodeConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_project_measures.test", "metric_keys.#", "3"),
+					testAccCheckNoEmptyMeasures("data.sonarcloud_project_measures.test"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckNoEmptyMeasures checks that measures on new code have their period value, and metrics without any value are left out
+func testAccCheckNoEmptyMeasures(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "measures.") && key != "measures.%" && value == "" {
+				return fmt.Errorf("%s: attribute '%s' is empty", name, key)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceProjectMeasuresConfig(project string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_project_measures" "test" {
+  project     = "%s"
+  metric_keys = ["ncloc", "security_rating"]
+}
+`, project)
+}
+
+func testAccDataSourceProjectMeasuresNewCodeConfig(project string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_project_measures" "test" {
+  project     = "%s"
+  metric_keys = ["new_coverage", "new_lines", "new_violations"]
+}
+`, project)
+}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
 )
@@ -44,19 +44,21 @@ func (d *QualityGateDataSource) Configure(ctx context.Context, req datasource.Co
 
 func (d QualityGateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "This Data Source retrieves a single Quality Gate for the configured Organization.",
+		Description: "This Data Source retrieves a single Quality Gate for the configured Organization, by name or by ID.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Id for Terraform backend",
 				Computed:    true,
 			},
 			"gate_id": schema.Float64Attribute{
-				Description: "Id created by SonarCloud",
+				Description: "Id created by SonarCloud. Either `gate_id` or `name` must be set.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the Quality Gate",
-				Required:    true,
+				Description: "Name of the Quality Gate. Either `gate_id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"is_default": schema.BoolAttribute{
 				Description: "Is this the default Quality gate for this project?",
@@ -66,8 +68,26 @@ func (d QualityGateDataSource) Schema(ctx context.Context, req datasource.Schema
 				Description: "Is this Quality gate built in?",
 				Computed:    true,
 			},
+			"cayc_status": schema.StringAttribute{
+				Description: "Whether the Quality Gate is compliant with Clean as You Code: `compliant`, `non-compliant` or `over-compliant`.",
+				Computed:    true,
+			},
+			"project_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The keys of the projects that have explicitly selected this Quality Gate. Projects that use the default Quality Gate are not included.",
+				Computed:    true,
+			},
+			"groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The names of the groups that can edit this Quality Gate.",
+				Computed:    true,
+			},
+			"users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The logins of the users that can edit this Quality Gate.",
+				Computed:    true,
+			},
 			"conditions": schema.SetNestedAttribute{
-				Optional:    true,
 				Computed:    true,
 				Description: "The conditions of this quality gate.",
 				NestedObject: schema.NestedAttributeObject{
@@ -77,18 +97,12 @@ func (d QualityGateDataSource) Schema(ctx context.Context, req datasource.Schema
 							Computed:    true,
 						},
 						"metric": schema.StringAttribute{
-							Description: "The metric on which the condition is based. Must be one of: https://docs.sonarqube.org/latest/user-guide/metric-definitions/",
+							Description: "The metric on which the condition is based. See the `sonarcloud_metrics` data source for all available metrics.",
 							Computed:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("security_rating", "ncloc_language_distribution", "test_execution_time", "statements", "lines_to_cover", "quality_gate_details", "new_reliabillity_remediation_effort", "tests", "security_review_rating", "new_xxx_violations", "conditions_by_line", "new_violations", "ncloc", "duplicated_lines", "test_failures", "test_errors", "reopened_issues", "new_vulnerabilities", "duplicated_lines_density", "test_success_density", "sqale_debt_ratio", "security_hotspots_reviewed", "security_remediation_effort", "covered_conditions_by_line", "classes", "sqale_rating", "xxx_violations", "true_positive_issues", "violations", "new_security_review_rating", "new_security_remediation_effort", "vulnerabillities", "new_uncovered_conditions", "files", "branch_coverage_hits_data", "uncovered_lines", "comment_lines_density", "new_uncovered_lines", "complexty", "cognitive_complexity", "uncovered_conditions", "functions", "new_technical_debt", "new_coverage", "coverage", "new_branch_coverage", "confirmed_issues", "reliabillity_remediation_effort", "projects", "coverage_line_hits_data", "code_smells", "directories", "lines", "bugs", "line_coverage", "new_line_coverage", "reliability_rating", "duplicated_blocks", "branch_coverage", "new_code_smells", "new_sqale_debt_ratio", "open_issues", "sqale_index", "new_lines_to_cover", "comment_lines", "skipped_tests"),
-							},
 						},
 						"op": schema.StringAttribute{
-							Description: "Operation on which the metric is evaluated must be either: LT, GT",
-							Optional:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf("LT", "GT"),
-							},
+							Description: "Operation on which the metric is evaluated, either: LT, GT",
+							Computed:    true,
 						},
 						"error": schema.StringAttribute{
 							Description: "The value on which the condition errors.",
@@ -101,9 +115,19 @@ func (d QualityGateDataSource) Schema(ctx context.Context, req datasource.Schema
 	}
 }
 
+func (d QualityGateDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("gate_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d QualityGateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config QualityGate
+	var config DataQualityGate
 	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -119,24 +143,28 @@ func (d QualityGateDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	result := QualityGate{}
-	for _, qualityGate := range response.Qualitygates {
-		if qualityGate.Name == config.Name.ValueString() {
-			for _, condition := range qualityGate.Conditions {
-				result.Conditions = append(result.Conditions, Condition{
-					Error:  types.StringValue(condition.Error),
-					ID:     types.Float64Value(condition.Id),
-					Metric: types.StringValue(condition.Metric),
-					Op:     types.StringValue(condition.Op),
-				})
-			}
-			result.ID = types.StringValue(fmt.Sprintf("%d", int(qualityGate.Id)))
-			result.GateId = types.Float64Value(qualityGate.Id)
-			result.Name = types.StringValue(qualityGate.Name)
-			result.IsDefault = types.BoolValue(qualityGate.IsDefault)
-			result.IsBuiltIn = types.BoolValue(qualityGate.IsBuiltIn)
-
-		}
+	var qualityGate QualityGate
+	var ok bool
+	if !config.GateId.IsNull() {
+		qualityGate, ok = findQualityGateByID(response, fmt.Sprintf("%d", int(config.GateId.ValueFloat64())))
+	} else {
+		qualityGate, ok = findQualityGate(response, config.Name.ValueString())
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Quality Gate",
+			"There is no Quality Gate with the configured name or ID.",
+		)
+		return
+	}
+
+	result, err := dataQualityGate(d.p, qualityGate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the Quality Gate details",
+			fmt.Sprintf("Reading the details of the Quality Gate '%s' failed: %+v", qualityGate.Name.ValueString(), err),
+		)
+		return
 	}
 
 	diags = resp.State.Set(ctx, result)
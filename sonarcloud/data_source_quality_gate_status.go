@@ -0,0 +1,163 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+type QualityGateStatusDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewQualityGateStatusDataSource() datasource.DataSource {
+	return &QualityGateStatusDataSource{}
+}
+
+func (*QualityGateStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quality_gate_status"
+}
+
+func (d *QualityGateStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d QualityGateStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves the quality gate status of the last analysis of a project, branch or pull request.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the project.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the branch. Defaults to the main branch.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("pull_request")),
+				},
+			},
+			"pull_request": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the pull request.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the quality gate. One of `OK`, `ERROR` or `NONE` if there is no analysis yet.",
+			},
+			"passed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the quality gate passed.",
+			},
+			"ignored_conditions": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether some conditions were ignored, because only few lines were changed.",
+			},
+			"conditions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The conditions of the quality gate and their values in the last analysis.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"metric": schema.StringAttribute{
+							Computed:    true,
+							Description: "The metric on which the condition is based.",
+						},
+						"op": schema.StringAttribute{
+							Computed:    true,
+							Description: "The operation with which the metric is compared to the threshold, either `LT` or `GT`.",
+						},
+						"error": schema.StringAttribute{
+							Computed:    true,
+							Description: "The threshold at which the condition fails.",
+						},
+						"actual_value": schema.StringAttribute{
+							Computed:    true,
+							Description: "The value of the metric in the last analysis.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the condition, either `OK` or `ERROR`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d QualityGateStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataQualityGateStatus
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := qualitygates.ProjectStatusRequest{
+		Branch:       config.Branch.ValueString(),
+		Organization: d.p.organization,
+		ProjectKey:   config.Project.ValueString(),
+		PullRequest:  config.PullRequest.ValueString(),
+	}
+
+	response, err := d.p.client.Qualitygates.ProjectStatus(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_status",
+			fmt.Sprintf("The ProjectStatus request returned an error: %+v", err),
+		)
+		return
+	}
+
+	status := response.ProjectStatus
+	conditions := make([]DataQualityGateStatusCondition, len(status.Conditions))
+	for i, condition := range status.Conditions {
+		conditions[i] = DataQualityGateStatusCondition{
+			Metric:      types.StringValue(condition.MetricKey),
+			Op:          types.StringValue(condition.Comparator),
+			Error:       types.StringValue(condition.ErrorThreshold),
+			ActualValue: optionalString(condition.ActualValue),
+			Status:      types.StringValue(condition.Status),
+		}
+	}
+
+	result := DataQualityGateStatus{
+		ID:                types.StringValue(fmt.Sprintf("%s-%s-%s", config.Project.ValueString(), config.Branch.ValueString(), config.PullRequest.ValueString())),
+		Project:           config.Project,
+		Branch:            config.Branch,
+		PullRequest:       config.PullRequest,
+		Status:            types.StringValue(status.Status),
+		Passed:            types.BoolValue(status.Status == "OK"),
+		IgnoredConditions: types.BoolValue(status.IgnoredConditions),
+		Conditions:        conditions,
+	}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,37 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceQualityGateStatus(t *testing.T) {
+	project := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceQualityGateStatusConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_gate_status.test", "project", project),
+					resource.TestMatchResourceAttr("data.sonarcloud_quality_gate_status.test", "status", regexp.MustCompile("^(OK|ERROR|NONE)$")),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_quality_gate_status.test", "passed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceQualityGateStatusConfig(project string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_quality_gate_status" "test" {
+  project = "%s"
+}
+`, project)
+}
@@ -3,6 +3,7 @@ package sonarcloud
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -25,8 +26,17 @@ func TestAccDataSourceQualityGate(t *testing.T) {
 				Config: testAccDataSourceQualityGateConfig(qualityGateName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.sonarcloud_quality_gate.test_quality_gate", "name", qualityGateName),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_quality_gate.test_quality_gate", "cayc_status"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_quality_gate.test_quality_gate", "project_keys.#"),
+					// The same gate is found by its ID
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_gate.by_id", "name", qualityGateName),
+					resource.TestCheckResourceAttrPair("data.sonarcloud_quality_gate.by_id", "gate_id", "data.sonarcloud_quality_gate.test_quality_gate", "gate_id"),
 				),
 			},
+			{
+				Config:      testAccDataSourceQualityGateNameAndIdConfig(qualityGateName),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
 		},
 	})
 }
@@ -36,5 +46,18 @@ func testAccDataSourceQualityGateConfig(qualityGateName string) string {
 data "sonarcloud_quality_gate" "test_quality_gate" {
 	name = "%s"
 }
+
+data "sonarcloud_quality_gate" "by_id" {
+	gate_id = data.sonarcloud_quality_gate.test_quality_gate.gate_id
+}
+`, qualityGateName)
+}
+
+func testAccDataSourceQualityGateNameAndIdConfig(qualityGateName string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_quality_gate" "test_quality_gate" {
+	name    = "%s"
+	gate_id = 1
+}
 `, qualityGateName)
 }
@@ -4,11 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
 )
@@ -51,6 +48,11 @@ func (d QualityGatesDataSource) Schema(ctx context.Context, req datasource.Schem
 				Description: "The index of the Quality Gate",
 				Computed:    true,
 			},
+			"include_details": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to retrieve `cayc_status`, `project_keys`, `groups` and `users` of every Quality Gate. Defaults to `false`." +
+					" **Note:** this takes several extra requests per Quality Gate, which slows down plans of organizations with many Quality Gates.",
+			},
 			"quality_gates": schema.SetNestedAttribute{
 				Computed:    true,
 				Description: "A quality gate",
@@ -76,8 +78,26 @@ func (d QualityGatesDataSource) Schema(ctx context.Context, req datasource.Schem
 							Description: "Is this Quality gate built in?",
 							Computed:    true,
 						},
+						"cayc_status": schema.StringAttribute{
+							Description: "Whether the Quality Gate is compliant with Clean as You Code: `compliant`, `non-compliant` or `over-compliant`. Only set with `include_details`.",
+							Computed:    true,
+						},
+						"project_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Description: "The keys of the projects that have explicitly selected this Quality Gate. Projects that use the default Quality Gate are not included. Only set with `include_details`.",
+							Computed:    true,
+						},
+						"groups": schema.SetAttribute{
+							ElementType: types.StringType,
+							Description: "The names of the groups that can edit this Quality Gate. Only set with `include_details`.",
+							Computed:    true,
+						},
+						"users": schema.SetAttribute{
+							ElementType: types.StringType,
+							Description: "The logins of the users that can edit this Quality Gate. Only set with `include_details`.",
+							Computed:    true,
+						},
 						"conditions": schema.SetNestedAttribute{
-							Optional:    true,
 							Computed:    true,
 							Description: "The conditions of this quality gate.",
 							NestedObject: schema.NestedAttributeObject{
@@ -87,18 +107,12 @@ func (d QualityGatesDataSource) Schema(ctx context.Context, req datasource.Schem
 										Computed:    true,
 									},
 									"metric": schema.StringAttribute{
-										Description: "The metric on which the condition is based. Must be one of: https://docs.sonarqube.org/latest/user-guide/metric-definitions/",
+										Description: "The metric on which the condition is based. See the `sonarcloud_metrics` data source for all available metrics.",
 										Computed:    true,
-										Validators: []validator.String{
-											stringvalidator.OneOf("security_rating", "ncloc_language_distribution", "test_execution_time", "statements", "lines_to_cover", "quality_gate_details", "new_reliabillity_remediation_effort", "tests", "security_review_rating", "new_xxx_violations", "conditions_by_line", "new_violations", "ncloc", "duplicated_lines", "test_failures", "test_errors", "reopened_issues", "new_vulnerabilities", "duplicated_lines_density", "test_success_density", "sqale_debt_ratio", "security_hotspots_reviewed", "security_remediation_effort", "covered_conditions_by_line", "classes", "sqale_rating", "xxx_violations", "true_positive_issues", "violations", "new_security_review_rating", "new_security_remediation_effort", "vulnerabillities", "new_uncovered_conditions", "files", "branch_coverage_hits_data", "uncovered_lines", "comment_lines_density", "new_uncovered_lines", "complexty", "cognitive_complexity", "uncovered_conditions", "functions", "new_technical_debt", "new_coverage", "coverage", "new_branch_coverage", "confirmed_issues", "reliabillity_remediation_effort", "projects", "coverage_line_hits_data", "code_smells", "directories", "lines", "bugs", "line_coverage", "new_line_coverage", "reliability_rating", "duplicated_blocks", "branch_coverage", "new_code_smells", "new_sqale_debt_ratio", "open_issues", "sqale_index", "new_lines_to_cover", "comment_lines", "skipped_tests"),
-										},
 									},
 									"op": schema.StringAttribute{
-										Description: "Operation on which the metric is evaluated must be either: LT, GT",
-										Optional:    true,
-										Validators: []validator.String{
-											stringvalidator.OneOf("LT", "GT"),
-										},
+										Description: "Operation on which the metric is evaluated, either: LT, GT",
+										Computed:    true,
 									},
 									"error": schema.StringAttribute{
 										Description: "The value on which the condition errors.",
@@ -115,7 +129,12 @@ func (d QualityGatesDataSource) Schema(ctx context.Context, req datasource.Schem
 }
 
 func (d QualityGatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var diags diag.Diagnostics
+	var config QualityGates
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	request := qualitygates.ListRequest{}
 
@@ -128,26 +147,28 @@ func (d QualityGatesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	result := QualityGates{}
-	var allQualityGates []QualityGate
-	for _, qualityGate := range response.Qualitygates {
-		var allConditions []Condition
-		for _, condition := range qualityGate.Conditions {
-			allConditions = append(allConditions, Condition{
-				Error:  types.StringValue(condition.Error),
-				ID:     types.Float64Value(condition.Id),
-				Metric: types.StringValue(condition.Metric),
-				Op:     types.StringValue(condition.Op),
-			})
+	result := QualityGates{
+		IncludeDetails: config.IncludeDetails,
+	}
+	var allQualityGates []DataQualityGate
+	for _, q := range response.Qualitygates {
+		qualityGate, _ := findQualityGate(response, q.Name)
+
+		// The details take several requests per gate, so they are only read when asked for
+		if !config.IncludeDetails.ValueBool() {
+			allQualityGates = append(allQualityGates, basicDataQualityGate(qualityGate))
+			continue
+		}
+
+		dataGate, err := dataQualityGate(d.p, qualityGate)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read the Quality Gate details",
+				fmt.Sprintf("Reading the details of the Quality Gate '%s' failed: %+v", q.Name, err),
+			)
+			return
 		}
-		allQualityGates = append(allQualityGates, QualityGate{
-			ID:         types.StringValue(fmt.Sprintf("%d", int(qualityGate.Id))),
-			GateId:     types.Float64Value(qualityGate.Id),
-			IsBuiltIn:  types.BoolValue(qualityGate.IsBuiltIn),
-			IsDefault:  types.BoolValue(qualityGate.IsDefault),
-			Name:       types.StringValue(qualityGate.Name),
-			Conditions: allConditions,
-		})
+		allQualityGates = append(allQualityGates, dataGate)
 	}
 	result.QualityGates = allQualityGates
 	result.ID = types.StringValue(d.p.organization)
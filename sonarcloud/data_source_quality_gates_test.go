@@ -18,6 +18,22 @@ func TestAccDataSourceQualityGates(t *testing.T) {
 				Config: testAccDataSourceQualityGatesConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.sonarcloud_quality_gates.test_quality_gates", "quality_gates.#", numberOfDefaultQualityGates),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_quality_gates.test_quality_gates", "quality_gates.*", map[string]string{
+						"name":        "Sonar way",
+						"is_built_in": "true",
+					}),
+					resource.TestCheckNoResourceAttr("data.sonarcloud_quality_gates.test_quality_gates", "quality_gates.0.cayc_status"),
+				),
+			},
+			{
+				Config: testAccDataSourceQualityGatesDetailsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_gates.test_quality_gates", "quality_gates.#", numberOfDefaultQualityGates),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_quality_gates.test_quality_gates", "quality_gates.*", map[string]string{
+						"name":        "Sonar way",
+						"is_built_in": "true",
+						"cayc_status": "compliant",
+					}),
 				),
 			},
 		},
@@ -29,3 +45,11 @@ func testAccDataSourceQualityGatesConfig() string {
 data "sonarcloud_quality_gates" "test_quality_gates" {}
 `)
 }
+
+func testAccDataSourceQualityGatesDetailsConfig() string {
+	return `
+data "sonarcloud_quality_gates" "test_quality_gates" {
+  include_details = true
+}
+`
+}
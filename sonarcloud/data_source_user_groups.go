@@ -49,6 +49,10 @@ func (d UserGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"default_group": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the group new members are added to per default.",
+			},
 			"groups": schema.SetNestedAttribute{
 				Computed:    true,
 				Description: "The groups of this organization.",
@@ -99,7 +103,7 @@ func (d UserGroupsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	allGroups := make([]Group, len(res.Groups))
 	for i, group := range res.Groups {
 		allGroups[i] = Group{
-			ID:           types.StringValue(big.NewFloat(group.Id).String()),
+			ID:           types.StringValue(groupID(group.Id)),
 			Default:      types.BoolValue(group.Default),
 			Description:  types.StringValue(group.Description),
 			MembersCount: types.NumberValue(big.NewFloat(group.MembersCount)),
@@ -108,6 +112,11 @@ func (d UserGroupsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 	result.Groups = allGroups
 	result.ID = types.StringValue(d.p.organization)
+	if group, ok := findDefaultGroup(res); ok {
+		result.DefaultGroup = group.Name
+	} else {
+		result.DefaultGroup = types.StringNull()
+	}
 
 	diags = resp.State.Set(ctx, result)
 
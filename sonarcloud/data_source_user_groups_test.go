@@ -17,6 +17,7 @@ func TestAccDataSourceUserGroups(t *testing.T) {
 				Config: testAccDataSourceUserGroupsConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.sonarcloud_user_groups.test_groups", "groups.#", numberOfDefaultGroups),
+					resource.TestCheckResourceAttr("data.sonarcloud_user_groups.test_groups", "default_group", builtInDefaultGroup),
 				),
 			},
 		},
@@ -0,0 +1,135 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/user_tokens"
+)
+
+type UserTokensDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewUserTokensDataSource() datasource.DataSource {
+	return &UserTokensDataSource{}
+}
+
+func (*UserTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_tokens"
+}
+
+func (d *UserTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d UserTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves all tokens of a user. The values of the tokens themselves cannot be read.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"login": schema.StringAttribute{
+				Required:    true,
+				Description: "The login of the user whose tokens are retrieved.",
+			},
+			"tokens": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The tokens of this user.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the token.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the token.",
+						},
+						"project_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the project the token can analyze. Only set for project analysis tokens.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time on which the token was created.",
+						},
+						"last_connection_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time on which the token was last used. Not set if the token has never been used.",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time on which the token expires. Not set for tokens without expiration date.",
+						},
+						"expired": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the token has expired.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d UserTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataUserTokens
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := user_tokens.SearchRequest{
+		Login: config.Login.ValueString(),
+	}
+
+	res, err := d.p.client.UserTokens.Search(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read user_tokens",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DataUserTokens{}
+	allTokens := make([]DataUserToken, len(res.UserTokens))
+	for i, token := range res.UserTokens {
+		allTokens[i] = DataUserToken{
+			Name:               types.StringValue(token.Name),
+			Type:               types.StringValue(token.Type),
+			ProjectKey:         optionalString(token.Project.Key),
+			CreatedAt:          optionalDateTime(token.CreatedAt),
+			LastConnectionDate: optionalDateTime(token.LastConnectionDate),
+			ExpiresAt:          optionalDateTime(token.ExpirationDate),
+			Expired:            types.BoolValue(token.IsExpired),
+		}
+	}
+	result.Tokens = allTokens
+	result.Login = config.Login
+	result.ID = config.Login
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,46 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceUserTokens(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TOKEN_TEST_USER_LOGIN")
+	name := "TEST DATA SOURCE TOKEN"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUserToken(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUserTokensConfig(login, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_user_tokens.test_tokens", "login", login),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_user_tokens.test_tokens", "tokens.*", map[string]string{
+						"name":    name,
+						"type":    "USER_TOKEN",
+						"expired": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUserTokensConfig(login string, name string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_user_token" "test_token" {
+	login = "%s"
+	name  = "%s"
+}
+
+data "sonarcloud_user_tokens" "test_tokens" {
+	login = sonarcloud_user_token.test_token.login
+
+	depends_on = [sonarcloud_user_token.test_token]
+}
+`, login, name)
+}
@@ -0,0 +1,218 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/webhooks"
+)
+
+// defaultWebhookDeliveries is the number of deliveries that is retrieved if max_results is not set
+const defaultWebhookDeliveries = 10
+
+type WebhookDeliveriesDataSource struct {
+	p *sonarcloudProvider
+}
+
+func NewWebhookDeliveriesDataSource() datasource.DataSource {
+	return &WebhookDeliveriesDataSource{}
+}
+
+func (*WebhookDeliveriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_deliveries"
+}
+
+func (d *WebhookDeliveriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (d WebhookDeliveriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source retrieves the most recent deliveries of a webhook, a project or a background task." +
+			" Exactly one of `webhook`, `project` or `ce_task_id` must be set." +
+			" **Note:** SonarCloud only delivers webhooks after an analysis and has no API to trigger a test delivery, so the provider does not support test deliveries.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"webhook": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the webhook to retrieve the deliveries for.",
+			},
+			"project": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the project to retrieve the deliveries for.",
+			},
+			"ce_task_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the background task to retrieve the deliveries for.",
+			},
+			"max_results": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum number of deliveries to retrieve. Defaults to %d.", defaultWebhookDeliveries),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 500),
+				},
+			},
+			"deliveries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The deliveries, the most recent delivery first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the delivery.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the webhook.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The url the payload was delivered to.",
+						},
+						"project": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key of the project the delivery was triggered for.",
+						},
+						"ce_task_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the background task that triggered the delivery.",
+						},
+						"delivered_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time of the delivery.",
+						},
+						"success": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the endpoint answered with a successful HTTP status code.",
+						},
+						"http_status": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The HTTP status code returned by the endpoint. Not set if the endpoint could not be reached.",
+						},
+						"duration_ms": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The duration of the delivery in milliseconds.",
+						},
+						"payload": schema.StringAttribute{
+							Computed:    true,
+							Description: "The payload that was delivered.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d WebhookDeliveriesDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("webhook"),
+			path.MatchRoot("project"),
+			path.MatchRoot("ce_task_id"),
+		),
+	}
+}
+
+func (d WebhookDeliveriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DataWebhookDeliveries
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := int64(defaultWebhookDeliveries)
+	if !config.MaxResults.IsNull() {
+		maxResults = config.MaxResults.ValueInt64()
+	}
+
+	// Fill in api action struct
+	request := webhooks.DeliveriesRequest{
+		CeTaskId:     config.CeTaskID.ValueString(),
+		ComponentKey: config.Project.ValueString(),
+		Ps:           strconv.FormatInt(maxResults, 10),
+		Webhook:      config.Webhook.ValueString(),
+	}
+
+	response, err := d.p.client.Webhooks.Deliveries(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the webhook_deliveries",
+			fmt.Sprintf("The Deliveries request returned an error: %+v", err),
+		)
+		return
+	}
+
+	deliveries := make([]DataWebhookDelivery, len(response.Deliveries))
+	for i, delivery := range response.Deliveries {
+		// The payload is only part of the details of a single delivery
+		detailsRequest := webhooks.DeliveryRequest{
+			DeliveryId: delivery.Id,
+		}
+
+		details, err := d.p.client.Webhooks.Delivery(detailsRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read the webhook_delivery",
+				fmt.Sprintf("The Delivery request for '%s' returned an error: %+v", delivery.Id, err),
+			)
+			return
+		}
+
+		httpStatus := types.Int64Null()
+		if delivery.HttpStatus != 0 {
+			httpStatus = types.Int64Value(int64(delivery.HttpStatus))
+		}
+
+		deliveries[i] = DataWebhookDelivery{
+			ID:          types.StringValue(delivery.Id),
+			Name:        types.StringValue(delivery.Name),
+			Url:         types.StringValue(delivery.Url),
+			Project:     types.StringValue(delivery.ComponentKey),
+			CeTaskID:    optionalString(delivery.CeTaskId),
+			DeliveredAt: optionalDateTime(delivery.At),
+			Success:     types.BoolValue(delivery.Success),
+			HttpStatus:  httpStatus,
+			DurationMs:  types.Int64Value(int64(delivery.DurationMs)),
+			Payload:     optionalString(details.Delivery.Payload),
+		}
+	}
+
+	result := DataWebhookDeliveries{
+		ID:         types.StringValue(fmt.Sprintf("%s-%s-%s", config.Webhook.ValueString(), config.Project.ValueString(), config.CeTaskID.ValueString())),
+		Webhook:    config.Webhook,
+		Project:    config.Project,
+		CeTaskID:   config.CeTaskID,
+		MaxResults: config.MaxResults,
+		Deliveries: deliveries,
+	}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,44 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceWebhookDeliveries(t *testing.T) {
+	project := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceWebhookDeliveriesConfig(project, organization),
+				Check: resource.ComposeTestCheckFunc(
+					// A new webhook has not delivered anything yet
+					resource.TestCheckResourceAttr("data.sonarcloud_webhook_deliveries.test", "deliveries.#", "0"),
+					resource.TestCheckResourceAttrPair("data.sonarcloud_webhook_deliveries.test", "webhook", "sonarcloud_webhook.test", "key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceWebhookDeliveriesConfig(project, organization string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_webhook" "test" {
+  name         = "test-deliveries"
+  project      = "%s"
+  organization = "%s"
+  url          = "https://www.example.com"
+}
+
+data "sonarcloud_webhook_deliveries" "test" {
+  webhook = sonarcloud_webhook.test.key
+}
+`, project, organization)
+}
@@ -0,0 +1,147 @@
+package sonarcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/user_tokens"
+)
+
+// userTokenPrivateKey is the key under which the token's login and name are stored in the private data, so Close can revoke it
+const userTokenPrivateKey = "user_token"
+
+type UserTokenEphemeralResource struct {
+	p *sonarcloudProvider
+}
+
+func NewUserTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &UserTokenEphemeralResource{}
+}
+
+func (*UserTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (d *UserTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (*UserTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This ephemeral resource generates a short-lived token for a user. The token is revoked as soon as Terraform" +
+			" no longer needs it and is never stored in the state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"login": schema.StringAttribute{
+				Required:    true,
+				Description: "The login of the user to which the token should be added. This should be the same user as configured in the provider.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the token. Must be unique for the user. Defaults to a generated name.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The value of the generated token.",
+			},
+		},
+	}
+}
+
+func (r UserTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Retrieve values from config
+	var config EphemeralToken
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	if config.Name.IsNull() || config.Name.IsUnknown() {
+		name = fmt.Sprintf("terraform-ephemeral-%d", time.Now().UnixNano())
+	}
+
+	// Fill in api action struct
+	request := user_tokens.GenerateRequest{
+		Login: config.Login.ValueString(),
+		Name:  name,
+	}
+
+	res, err := r.p.client.UserTokens.Generate(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not generate the user_token",
+			fmt.Sprintf("The Generate request returned an error: %+v", err),
+		)
+		return
+	}
+
+	// Remember which token to revoke when Terraform closes the ephemeral resource
+	private, err := json.Marshal(user_tokens.RevokeRequest{
+		Login: res.Login,
+		Name:  res.Name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not store the user_token details",
+			fmt.Sprintf("Marshalling the token details returned an error: %+v", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userTokenPrivateKey, private)...)
+
+	result := EphemeralToken{
+		Login: types.StringValue(res.Login),
+		Name:  types.StringValue(res.Name),
+		Token: types.StringValue(res.Token),
+	}
+	diags = resp.Result.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r UserTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, userTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var request user_tokens.RevokeRequest
+	if err := json.Unmarshal(private, &request); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the user_token details",
+			fmt.Sprintf("Unmarshalling the token details returned an error: %+v", err),
+		)
+		return
+	}
+
+	err := r.p.client.UserTokens.Revoke(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not revoke the user_token",
+			fmt.Sprintf("The Revoke request returned an error: %+v", err),
+		)
+	}
+}
@@ -0,0 +1,33 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEphemeralUserToken(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TOKEN_TEST_USER_LOGIN")
+
+	// Ephemeral values are never written to the state, so this test can only verify that
+	// the token is generated and revoked again without errors. Requires Terraform 1.10 or later.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUserToken(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralUserTokenConfig(login),
+			},
+		},
+	})
+}
+
+func testAccEphemeralUserTokenConfig(login string) string {
+	return fmt.Sprintf(`
+ephemeral "sonarcloud_user_token" "test_token" {
+	login = "%s"
+}
+`, login)
+}
@@ -3,6 +3,8 @@ package sonarcloud
 import (
 	"fmt"
 	"math/big"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,9 +12,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/navigation"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/organizations"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/permissions"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/project_branches"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/projects"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
@@ -51,7 +57,7 @@ func findGroup(response *user_groups.SearchResponseAll, name string) (Group, boo
 	for _, g := range response.Groups {
 		if g.Name == name {
 			result = Group{
-				ID:           types.StringValue(big.NewFloat(g.Id).String()),
+				ID:           types.StringValue(groupID(g.Id)),
 				Default:      types.BoolValue(g.Default),
 				Description:  types.StringValue(g.Description),
 				MembersCount: types.NumberValue(big.NewFloat(g.MembersCount)),
@@ -64,7 +70,48 @@ func findGroup(response *user_groups.SearchResponseAll, name string) (Group, boo
 	return result, ok
 }
 
-// findGroup returns the group member with the given login if it exists in the response
+// findGroupByID returns the group with the given numeric ID if it exists in the response
+func findGroupByID(response *user_groups.SearchResponseAll, id string) (Group, bool) {
+	wanted, err := parseGroupID(id)
+	if err != nil {
+		return Group{}, false
+	}
+	for _, g := range response.Groups {
+		if int64(g.Id) == wanted {
+			return findGroup(response, g.Name)
+		}
+	}
+	return Group{}, false
+}
+
+// groupID formats the numeric ID of a group as an integer, so large IDs are not rounded or written in exponent notation
+func groupID(id float64) string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+// parseGroupID parses the numeric ID of a group. IDs in the state of earlier provider versions can be written in exponent notation, e.g. 1.234567891e+09.
+func parseGroupID(id string) (int64, error) {
+	if parsed, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return parsed, nil
+	}
+	parsed, err := strconv.ParseFloat(id, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(parsed), nil
+}
+
+// findDefaultGroup returns the default group of the organization if it exists in the response
+func findDefaultGroup(response *user_groups.SearchResponseAll) (Group, bool) {
+	for _, g := range response.Groups {
+		if g.Default {
+			return findGroup(response, g.Name)
+		}
+	}
+	return Group{}, false
+}
+
+// findGroupMember returns the group member with the given login if it exists in the response
 func findGroupMember(response *user_groups.UsersResponseAll, group string, login string) (GroupMember, bool) {
 	var result GroupMember
 	ok := false
@@ -81,14 +128,193 @@ func findGroupMember(response *user_groups.UsersResponseAll, group string, login
 	return result, ok
 }
 
-// tokenExists returns whether a token with the given name exists in the response
-func tokenExists(response *user_tokens.SearchResponse, name string) bool {
+// groupMemberLogins returns the logins of all users in the response as a set
+func groupMemberLogins(response *user_groups.UsersResponseAll) types.Set {
+	logins := make([]attr.Value, len(response.Users))
+	for i, u := range response.Users {
+		logins[i] = types.StringValue(u.Login)
+	}
+	return types.SetValueMust(types.StringType, logins)
+}
+
+// readQualityGatePermissions returns all groups and users that can currently edit the quality gate
+func readQualityGatePermissions(p *sonarcloudProvider, gateId, gateName string) (*QualityGatePermissions, error) {
+	groupsRequest := qualitygates.SearchGroupsRequest{
+		GateName:     gateName,
+		Organization: p.organization,
+		Selected:     "selected",
+	}
+
+	groups, err := p.client.Qualitygates.SearchGroupsAll(groupsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	usersRequest := qualitygates.SearchUsersRequest{
+		GateName:     gateName,
+		Organization: p.organization,
+		Selected:     "selected",
+	}
+
+	users, err := p.client.Qualitygates.SearchUsersAll(usersRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QualityGatePermissions{
+		ID:     types.StringValue(gateId),
+		GateId: types.StringValue(gateId),
+		Groups: qualityGateGroupNames(groups),
+		Users:  qualityGateUserLogins(users),
+	}, nil
+}
+
+// qualityGateGroupNames returns the names of all groups in the response as a set
+func qualityGateGroupNames(response *qualitygates.SearchGroupsResponseAll) types.Set {
+	names := make([]attr.Value, len(response.Groups))
+	for i, g := range response.Groups {
+		names[i] = types.StringValue(g.Name)
+	}
+	return types.SetValueMust(types.StringType, names)
+}
+
+// qualityGateUserLogins returns the logins of all users in the response as a set
+func qualityGateUserLogins(response *qualitygates.SearchUsersResponseAll) types.Set {
+	logins := make([]attr.Value, len(response.Users))
+	for i, u := range response.Users {
+		logins[i] = types.StringValue(u.Login)
+	}
+	return types.SetValueMust(types.StringType, logins)
+}
+
+// readOrganization returns the settings and subscription of the configured organization
+func readOrganization(p *sonarcloudProvider) (DataOrganization, error) {
+	searchRequest := organizations.SearchRequest{
+		Organizations: p.organization,
+	}
+
+	search, err := p.client.Organizations.Search(searchRequest)
+	if err != nil {
+		return DataOrganization{}, fmt.Errorf("the Search request returned an error: %+v", err)
+	}
+	if len(search.Organizations) == 0 {
+		return DataOrganization{}, fmt.Errorf("the organization '%s' does not exist", p.organization)
+	}
+	org := search.Organizations[0]
+
+	// The default visibility and the subscription are only part of the navigation information
+	navigationRequest := navigation.OrganizationRequest{
+		Organization: p.organization,
+	}
+
+	nav, err := p.client.Navigation.Organization(navigationRequest)
+	if err != nil {
+		return DataOrganization{}, fmt.Errorf("the navigation Organization request returned an error: %+v", err)
+	}
+
+	templatesRequest := permissions.SearchTemplatesRequest{
+		Organization: p.organization,
+	}
+
+	templates, err := p.client.Permissions.SearchTemplates(templatesRequest)
+	if err != nil {
+		return DataOrganization{}, fmt.Errorf("the SearchTemplates request returned an error: %+v", err)
+	}
+
+	return DataOrganization{
+		ID:                        types.StringValue(org.Key),
+		Name:                      types.StringValue(org.Name),
+		Description:               types.StringValue(org.Description),
+		Url:                       types.StringValue(org.Url),
+		Avatar:                    types.StringValue(org.Avatar),
+		DefaultVisibility:         types.StringValue(nav.Organization.ProjectVisibility),
+		DefaultPermissionTemplate: optionalString(defaultPermissionTemplate(templates)),
+		Subscription:              types.StringValue(nav.Organization.Subscription),
+		PrivateProjectsAllowed:    privateProjectsAllowed(nav.Organization.Subscription),
+		CanMakeProjectsPrivate:    types.BoolValue(nav.Organization.CanUpdateProjectsVisibilityToPrivate),
+	}, nil
+}
+
+// privateProjectsAllowed returns whether the subscription plan allows private projects, or null if the plan is not known.
+// Only the free plan is limited to public projects.
+func privateProjectsAllowed(subscription string) types.Bool {
+	if subscription == "" {
+		return types.BoolNull()
+	}
+	return types.BoolValue(subscription != "FREE")
+}
+
+// defaultPermissionTemplate returns the name of the permission template that is applied to new projects
+func defaultPermissionTemplate(response *permissions.SearchTemplatesResponse) string {
+	for _, d := range response.DefaultTemplates {
+		if d.Qualifier != "TRK" {
+			continue
+		}
+		for _, t := range response.PermissionTemplates {
+			if t.Id == d.TemplateId {
+				return t.Name
+			}
+		}
+	}
+	return ""
+}
+
+// findOrganizationMember returns the organization member with the given login if it exists in the response
+func findOrganizationMember(response *organizations.SearchMembersResponseAll, login string) (OrganizationMember, bool) {
+	var result OrganizationMember
+	ok := false
+	for _, u := range response.Users {
+		if u.Login == login {
+			result = OrganizationMember{
+				ID:    types.StringValue(u.Login),
+				Login: types.StringValue(u.Login),
+				Name:  types.StringValue(u.Name),
+			}
+			ok = true
+			break
+		}
+	}
+	return result, ok
+}
+
+// findUserToken returns the token from the state updated with the details from the response, if it exists in the response.
+// The value of the token itself cannot be read, so it is kept from the state.
+func findUserToken(response *user_tokens.SearchResponse, state Token) (Token, bool) {
 	for _, t := range response.UserTokens {
-		if t.Name == name {
-			return true
+		if t.Name != state.Name.ValueString() {
+			continue
+		}
+
+		result := state
+		if t.Type != "" {
+			result.Type = types.StringValue(t.Type)
 		}
+		if t.Project.Key != "" {
+			result.ProjectKey = types.StringValue(t.Project.Key)
+		}
+		result.CreatedAt = optionalDateTime(t.CreatedAt)
+		result.LastConnectionDate = optionalDateTime(t.LastConnectionDate)
+		result.ExpiresAt = optionalDateTime(t.ExpirationDate)
+		return result, true
 	}
-	return false
+	return Token{}, false
+}
+
+// optionalString returns a null string for empty values, so optional API fields do not show up as empty strings
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// optionalDateTime converts a date returned by SonarCloud to RFC 3339, so it can be used with the Terraform time functions.
+// Values that cannot be parsed are returned as is.
+func optionalDateTime(value string) types.String {
+	if t, err := time.Parse(sonarcloudDateTimeLayout, value); err == nil {
+		return types.StringValue(t.Format(time.RFC3339))
+	}
+	return optionalString(value)
 }
 
 // findProject returns the project with the given key if it exists in the response
@@ -156,6 +382,88 @@ func findQualityGate(response *qualitygates.ListResponse, name string) (QualityG
 	return result, ok
 }
 
+// nonGateMetricTypes are the metric types that cannot be compared to a threshold
+var nonGateMetricTypes = []string{"BOOL", "DATA", "DISTRIB", "STRING"}
+
+// nonGateMetrics are metrics of comparable types that SonarCloud still does not allow in quality gate conditions
+var nonGateMetrics = []string{"alert_status", "security_hotspots", "new_security_hotspots"}
+
+// metricAllowedInGates guesses whether a metric can be used in the conditions of a quality gate.
+// SonarCloud does not expose this in the metrics catalog, so keep the lists above up to date.
+func metricAllowedInGates(key, metricType string, hidden bool) bool {
+	return !hidden && !slices.Contains(nonGateMetricTypes, metricType) && !slices.Contains(nonGateMetrics, key)
+}
+
+// findQualityGateByID returns the quality gate with the given ID if it exists in a response
+func findQualityGateByID(response *qualitygates.ListResponse, id string) (QualityGate, bool) {
+	for _, q := range response.Qualitygates {
+		if fmt.Sprintf("%d", int(q.Id)) == id {
+			return findQualityGate(response, q.Name)
+		}
+	}
+	return QualityGate{}, false
+}
+
+// findBuiltInQualityGate returns the built-in quality gate (Sonar way) if it exists in a response
+func findBuiltInQualityGate(response *qualitygates.ListResponse) (QualityGate, bool) {
+	for _, q := range response.Qualitygates {
+		if q.IsBuiltIn {
+			return findQualityGate(response, q.Name)
+		}
+	}
+	return QualityGate{}, false
+}
+
+// findDefaultQualityGate returns the default quality gate of the organization if it exists in a response
+func findDefaultQualityGate(response *qualitygates.ListResponse) (QualityGate, bool) {
+	for _, q := range response.Qualitygates {
+		if q.IsDefault {
+			return findQualityGate(response, q.Name)
+		}
+	}
+	return QualityGate{}, false
+}
+
+// resetDefaultQualityGate makes the built-in quality gate the default gate of the organization again.
+// The ID of the built-in gate differs per organization, so it is looked up.
+func resetDefaultQualityGate(p *sonarcloudProvider) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	listRequest := qualitygates.ListRequest{
+		Organization: p.organization,
+	}
+
+	response, err := p.client.Qualitygates.List(listRequest)
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Gates",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return diags
+	}
+
+	builtIn, ok := findBuiltInQualityGate(response)
+	if !ok {
+		diags.AddError(
+			"Could not find the built-in Quality Gate",
+			"The default Quality Gate cannot be reset, because the organization has no built-in Quality Gate.",
+		)
+		return diags
+	}
+
+	request := qualitygates.SetAsDefaultRequest{
+		Id:           builtIn.ID.ValueString(),
+		Organization: p.organization,
+	}
+	if err := p.client.Qualitygates.SetAsDefault(request); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Could not set the `%s` Quality Gate as default", builtIn.Name.ValueString()),
+			fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
+		)
+	}
+	return diags
+}
+
 // findSelection returns a Selection{} struct with the given project keys if they exist in a response
 // this can be sped up using hashmaps, but I didn't feel like introducing a new dependency/taking code from somewhere.
 // Ex library: https://pkg.go.dev/github.com/juliangruber/go-intersect/v2
@@ -180,6 +488,93 @@ func findSelection(response *qualitygates.SearchResponse, keys []attr.Value) (Se
 	}, ok
 }
 
+// dataQualityGate returns the details of a quality gate including its Clean as You Code status, selected projects and permission holders
+func dataQualityGate(p *sonarcloudProvider, gate QualityGate) (DataQualityGate, error) {
+	showRequest := qualitygates.ShowRequest{
+		Id:           gate.ID.ValueString(),
+		Organization: p.organization,
+	}
+
+	show, err := p.client.Qualitygates.Show(showRequest)
+	if err != nil {
+		return DataQualityGate{}, fmt.Errorf("the Show request returned an error: %+v", err)
+	}
+
+	selection, err := searchQualityGateProjects(p, gate.ID.ValueString())
+	if err != nil {
+		return DataQualityGate{}, fmt.Errorf("the Search request returned an error: %+v", err)
+	}
+
+	permissions, err := readQualityGatePermissions(p, gate.ID.ValueString(), gate.Name.ValueString())
+	if err != nil {
+		return DataQualityGate{}, fmt.Errorf("the permissions search request returned an error: %+v", err)
+	}
+
+	result := basicDataQualityGate(gate)
+	result.CaycStatus = types.StringValue(show.CaycStatus)
+	result.ProjectKeys = allSelected(selection).ProjectKeys
+	result.Groups = permissions.Groups
+	result.Users = permissions.Users
+	return result, nil
+}
+
+// basicDataQualityGate returns the data source model of a quality gate without the details that take extra requests
+func basicDataQualityGate(gate QualityGate) DataQualityGate {
+	return DataQualityGate{
+		ID:          gate.ID,
+		GateId:      gate.GateId,
+		Conditions:  gate.Conditions,
+		IsBuiltIn:   gate.IsBuiltIn,
+		IsDefault:   gate.IsDefault,
+		Name:        gate.Name,
+		CaycStatus:  types.StringNull(),
+		ProjectKeys: types.SetNull(types.StringType),
+		Groups:      types.SetNull(types.StringType),
+		Users:       types.SetNull(types.StringType),
+	}
+}
+
+// qualityGateProjectsPageSize is the number of projects that are requested per page when searching the projects of a quality gate
+const qualityGateProjectsPageSize = 500
+
+// searchQualityGateProjects returns all projects that are selected on the quality gate, the results of all pages are combined into one response
+func searchQualityGateProjects(p *sonarcloudProvider, gateId string) (*qualitygates.SearchResponse, error) {
+	result := &qualitygates.SearchResponse{}
+	for page := 1; ; page++ {
+		request := qualitygates.SearchRequest{
+			GateId:       gateId,
+			Organization: p.organization,
+			Page:         fmt.Sprintf("%d", page),
+			PageSize:     fmt.Sprintf("%d", qualityGateProjectsPageSize),
+			Selected:     "selected",
+		}
+
+		res, err := p.client.Qualitygates.Search(request)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Results = append(result.Results, res.Results...)
+		if !res.More || len(res.Results) == 0 {
+			result.Paging = res.Paging
+			return result, nil
+		}
+	}
+}
+
+// allSelected returns a Selection{} struct with all selected projects in a response
+func allSelected(response *qualitygates.SearchResponse) Selection {
+	projectKeys := make([]attr.Value, 0, len(response.Results))
+	for _, s := range response.Results {
+		if s.Selected {
+			projectKeys = append(projectKeys, types.StringValue(s.Key))
+		}
+	}
+	return Selection{
+		ProjectKeys: types.SetValueMust(types.StringType, projectKeys),
+	}
+}
+
 // terraformListString returns the list of items in terraform list notation
 func terraformListString(items []string) string {
 	return fmt.Sprintf(`["%s"]`, strings.Join(items, `","`))
@@ -219,3 +614,51 @@ func diffAttrSets(haves, wants types.Set) (toAdd, toRemove []attr.Value) {
 
 	return toAdd, toRemove
 }
+
+// globalPermissions are the permissions that can be granted for the whole organization
+var globalPermissions = []string{"admin", "profileadmin", "gateadmin", "scan", "provisioning"}
+
+// projectPermissions are the permissions that can be granted for a specific project
+var projectPermissions = []string{"admin", "scan", "codeviewer", "issueadmin", "securityhotspotadmin", "user"}
+
+// validatePermissionsScope checks that all permissions are valid for the scope implied by the project key.
+// An empty or null project key means the permissions are granted for the whole organization.
+// Unknown values are skipped, they will be validated once they are known.
+func validatePermissionsScope(projectKey types.String, permissions types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if projectKey.IsUnknown() || permissions.IsNull() || permissions.IsUnknown() {
+		return diags
+	}
+
+	scope := "global"
+	allowed := globalPermissions
+	if projectKey.ValueString() != "" {
+		scope = "project"
+		allowed = projectPermissions
+	}
+
+	var invalid []string
+	for _, elem := range permissions.Elements() {
+		permission, ok := elem.(types.String)
+		if !ok || permission.IsUnknown() || permission.IsNull() {
+			continue
+		}
+		if !slices.Contains(allowed, permission.ValueString()) {
+			invalid = append(invalid, permission.ValueString())
+		}
+	}
+
+	if len(invalid) > 0 {
+		diags.AddAttributeError(
+			path.Root("permissions"),
+			"Invalid permissions for scope",
+			fmt.Sprintf("The following permissions cannot be granted as %s permissions: %s. Available %s permissions: %s.",
+				scope,
+				terraformListString(invalid),
+				scope,
+				terraformListString(allowed),
+			),
+		)
+	}
+	return diags
+}
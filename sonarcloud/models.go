@@ -3,8 +3,9 @@ package sonarcloud
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type Groups struct {
-	ID     types.String `tfsdk:"id"`
-	Groups []Group      `tfsdk:"groups"`
+	ID           types.String `tfsdk:"id"`
+	DefaultGroup types.String `tfsdk:"default_group"`
+	Groups       []Group      `tfsdk:"groups"`
 }
 
 type Group struct {
@@ -15,12 +16,23 @@ type Group struct {
 	Name         types.String `tfsdk:"name"`
 }
 
+type DefaultGroup struct {
+	ID    types.String `tfsdk:"id"`
+	Group types.String `tfsdk:"group"`
+}
+
 type GroupMember struct {
 	ID    types.String `tfsdk:"id"`
 	Group types.String `tfsdk:"group"`
 	Login types.String `tfsdk:"login"`
 }
 
+type GroupMembers struct {
+	ID     types.String `tfsdk:"id"`
+	Group  types.String `tfsdk:"group"`
+	Logins types.Set    `tfsdk:"logins"`
+}
+
 type User struct {
 	Login types.String `tfsdk:"login"`
 	Name  types.String `tfsdk:"name"`
@@ -32,13 +44,107 @@ type Users struct {
 	Users []User       `tfsdk:"users"`
 }
 
-type Token struct {
+type OrganizationMember struct {
 	ID    types.String `tfsdk:"id"`
 	Login types.String `tfsdk:"login"`
 	Name  types.String `tfsdk:"name"`
+}
+
+type DataOrganizationMember struct {
+	Login  types.String `tfsdk:"login"`
+	Name   types.String `tfsdk:"name"`
+	Groups types.Set    `tfsdk:"groups"`
+}
+
+type DataOrganizationMembers struct {
+	ID      types.String             `tfsdk:"id"`
+	Members []DataOrganizationMember `tfsdk:"members"`
+}
+
+type Organization struct {
+	ID                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Description               types.String `tfsdk:"description"`
+	Url                       types.String `tfsdk:"url"`
+	Avatar                    types.String `tfsdk:"avatar"`
+	DefaultVisibility         types.String `tfsdk:"default_visibility"`
+	DefaultPermissionTemplate types.String `tfsdk:"default_permission_template"`
+}
+
+type DataOrganization struct {
+	ID                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Description               types.String `tfsdk:"description"`
+	Url                       types.String `tfsdk:"url"`
+	Avatar                    types.String `tfsdk:"avatar"`
+	DefaultVisibility         types.String `tfsdk:"default_visibility"`
+	DefaultPermissionTemplate types.String `tfsdk:"default_permission_template"`
+	Subscription              types.String `tfsdk:"subscription"`
+	PrivateProjectsAllowed    types.Bool   `tfsdk:"private_projects_allowed"`
+	CanMakeProjectsPrivate    types.Bool   `tfsdk:"can_make_projects_private"`
+}
+
+type Token struct {
+	ID                 types.String `tfsdk:"id"`
+	Login              types.String `tfsdk:"login"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	ProjectKey         types.String `tfsdk:"project_key"`
+	ExpirationDate     types.String `tfsdk:"expiration_date"`
+	RotationDays       types.Int64  `tfsdk:"rotation_days"`
+	RotateBefore       types.Int64  `tfsdk:"rotate_before"`
+	Token              types.String `tfsdk:"token"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	LastConnectionDate types.String `tfsdk:"last_connection_date"`
+	ExpiresAt          types.String `tfsdk:"expires_at"`
+}
+
+type DataUserTokens struct {
+	ID     types.String    `tfsdk:"id"`
+	Login  types.String    `tfsdk:"login"`
+	Tokens []DataUserToken `tfsdk:"tokens"`
+}
+
+type DataUserToken struct {
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	ProjectKey         types.String `tfsdk:"project_key"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	LastConnectionDate types.String `tfsdk:"last_connection_date"`
+	ExpiresAt          types.String `tfsdk:"expires_at"`
+	Expired            types.Bool   `tfsdk:"expired"`
+}
+
+type EphemeralToken struct {
+	Login types.String `tfsdk:"login"`
+	Name  types.String `tfsdk:"name"`
 	Token types.String `tfsdk:"token"`
 }
 
+type DataProjectBadge struct {
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+	Branch  types.String `tfsdk:"branch"`
+	Metrics types.List   `tfsdk:"metrics"`
+	Token   types.String `tfsdk:"token"`
+	Badges  types.Map    `tfsdk:"badges"`
+}
+
+type ProjectBadgeToken struct {
+	ID       types.String `tfsdk:"id"`
+	Project  types.String `tfsdk:"project"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Token    types.String `tfsdk:"token"`
+}
+
+type DataProjectMeasures struct {
+	ID         types.String `tfsdk:"id"`
+	Project    types.String `tfsdk:"project"`
+	Branch     types.String `tfsdk:"branch"`
+	MetricKeys types.List   `tfsdk:"metric_keys"`
+	Measures   types.Map    `tfsdk:"measures"`
+}
+
 type Projects struct {
 	ID       types.String `tfsdk:"id"`
 	Projects []Project    `tfsdk:"projects"`
@@ -76,17 +182,89 @@ type QualityGate struct {
 	IsBuiltIn  types.Bool    `tfsdk:"is_built_in"`
 	IsDefault  types.Bool    `tfsdk:"is_default"`
 	Name       types.String  `tfsdk:"name"`
+	CopyFrom   types.String  `tfsdk:"copy_from"`
+}
+
+type DataQualityGate struct {
+	ID          types.String  `tfsdk:"id"`
+	GateId      types.Float64 `tfsdk:"gate_id"`
+	Conditions  []Condition   `tfsdk:"conditions"`
+	IsBuiltIn   types.Bool    `tfsdk:"is_built_in"`
+	IsDefault   types.Bool    `tfsdk:"is_default"`
+	Name        types.String  `tfsdk:"name"`
+	CaycStatus  types.String  `tfsdk:"cayc_status"`
+	ProjectKeys types.Set     `tfsdk:"project_keys"`
+	Groups      types.Set     `tfsdk:"groups"`
+	Users       types.Set     `tfsdk:"users"`
+}
+
+type DefaultQualityGate struct {
+	ID     types.String `tfsdk:"id"`
+	GateId types.String `tfsdk:"gate_id"`
+	Name   types.String `tfsdk:"name"`
+}
+
+type QualityGatePermissions struct {
+	ID     types.String `tfsdk:"id"`
+	GateId types.String `tfsdk:"gate_id"`
+	Groups types.Set    `tfsdk:"groups"`
+	Users  types.Set    `tfsdk:"users"`
+}
+
+type ProjectQualityGate struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	GateId     types.String `tfsdk:"gate_id"`
+	GateName   types.String `tfsdk:"gate_name"`
 }
 
 type QualityGates struct {
-	ID           types.String  `tfsdk:"id"`
-	QualityGates []QualityGate `tfsdk:"quality_gates"`
+	ID             types.String      `tfsdk:"id"`
+	IncludeDetails types.Bool        `tfsdk:"include_details"`
+	QualityGates   []DataQualityGate `tfsdk:"quality_gates"`
+}
+
+type DataQualityGateStatus struct {
+	ID                types.String                     `tfsdk:"id"`
+	Project           types.String                     `tfsdk:"project"`
+	Branch            types.String                     `tfsdk:"branch"`
+	PullRequest       types.String                     `tfsdk:"pull_request"`
+	Status            types.String                     `tfsdk:"status"`
+	Passed            types.Bool                       `tfsdk:"passed"`
+	IgnoredConditions types.Bool                       `tfsdk:"ignored_conditions"`
+	Conditions        []DataQualityGateStatusCondition `tfsdk:"conditions"`
+}
+
+type DataQualityGateStatusCondition struct {
+	Metric      types.String `tfsdk:"metric"`
+	Op          types.String `tfsdk:"op"`
+	Error       types.String `tfsdk:"error"`
+	ActualValue types.String `tfsdk:"actual_value"`
+	Status      types.String `tfsdk:"status"`
+}
+
+type DataMetrics struct {
+	ID      types.String `tfsdk:"id"`
+	Metrics []DataMetric `tfsdk:"metrics"`
+}
+
+type DataMetric struct {
+	Key            types.String `tfsdk:"key"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	Domain         types.String `tfsdk:"domain"`
+	Direction      types.Int64  `tfsdk:"direction"`
+	Qualitative    types.Bool   `tfsdk:"qualitative"`
+	Hidden         types.Bool   `tfsdk:"hidden"`
+	AllowedInGates types.Bool   `tfsdk:"allowed_in_gates"`
 }
 
 type Selection struct {
 	ID          types.String `tfsdk:"id"`
 	GateId      types.String `tfsdk:"gate_id"`
 	ProjectKeys types.Set    `tfsdk:"project_keys"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
 }
 
 type DataUserGroupPermissionsGroup struct {
@@ -165,12 +343,37 @@ type DataWebhook struct {
 	Url       types.String `tfsdk:"url"`
 }
 
+type DataWebhookDeliveries struct {
+	ID         types.String          `tfsdk:"id"`
+	Webhook    types.String          `tfsdk:"webhook"`
+	Project    types.String          `tfsdk:"project"`
+	CeTaskID   types.String          `tfsdk:"ce_task_id"`
+	MaxResults types.Int64           `tfsdk:"max_results"`
+	Deliveries []DataWebhookDelivery `tfsdk:"deliveries"`
+}
+
+type DataWebhookDelivery struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Url         types.String `tfsdk:"url"`
+	Project     types.String `tfsdk:"project"`
+	CeTaskID    types.String `tfsdk:"ce_task_id"`
+	DeliveredAt types.String `tfsdk:"delivered_at"`
+	Success     types.Bool   `tfsdk:"success"`
+	HttpStatus  types.Int64  `tfsdk:"http_status"`
+	DurationMs  types.Int64  `tfsdk:"duration_ms"`
+	Payload     types.String `tfsdk:"payload"`
+}
+
 type Webhook struct {
-	ID           types.String `tfsdk:"id"`
-	Key          types.String `tfsdk:"key"`
-	Project      types.String `tfsdk:"project"`
-	Organization types.String `tfsdk:"organization"`
-	Name         types.String `tfsdk:"name"`
-	Secret       types.String `tfsdk:"secret"`
-	Url          types.String `tfsdk:"url"`
+	ID              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Project         types.String `tfsdk:"project"`
+	Organization    types.String `tfsdk:"organization"`
+	Name            types.String `tfsdk:"name"`
+	Secret          types.String `tfsdk:"secret"`
+	SecretWO        types.String `tfsdk:"secret_wo"`
+	SecretWOVersion types.Int64  `tfsdk:"secret_wo_version"`
+	HasSecret       types.Bool   `tfsdk:"has_secret"`
+	Url             types.String `tfsdk:"url"`
 }
@@ -3,13 +3,16 @@ package sonarcloud
 import (
 	"context"
 	"os"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kauppine/go-sonarcloud/sonarcloud"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/metrics"
 )
 
 func New() provider.Provider {
@@ -20,6 +23,10 @@ type sonarcloudProvider struct {
 	configured   bool
 	client       *sonarcloud.Client
 	organization string
+
+	// metrics caches the metrics catalog, it is looked up for every quality gate during plan
+	metricsMutex sync.Mutex
+	metrics      *metrics.SearchResponseAll
 }
 
 type providerData struct {
@@ -31,7 +38,7 @@ func (p *sonarcloudProvider) Metadata(_ context.Context, req provider.MetadataRe
 	resp.TypeName = "sonarcloud"
 }
 
-func (p sonarcloudProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+func (p *sonarcloudProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
@@ -94,21 +101,47 @@ func (p *sonarcloudProvider) Configure(ctx context.Context, req provider.Configu
 
 	resp.DataSourceData = p
 	resp.ResourceData = p
+	resp.EphemeralResourceData = p
+}
+
+// metricsCatalog returns all metrics of SonarCloud. The catalog is only requested once per provider run.
+func (p *sonarcloudProvider) metricsCatalog() (*metrics.SearchResponseAll, error) {
+	p.metricsMutex.Lock()
+	defer p.metricsMutex.Unlock()
+
+	if p.metrics != nil {
+		return p.metrics, nil
+	}
+
+	response, err := p.client.Metrics.SearchAll(metrics.SearchRequest{})
+	if err != nil {
+		return nil, err
+	}
+	p.metrics = response
+	return response, nil
 }
 
 func (p *sonarcloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUserGroupResource,
 		NewUserGroupMemberResource,
+		NewUserGroupMembersResource,
+		NewDefaultGroupResource,
 		NewProjectResource,
 		NewProjectLinkResource,
 		NewProjectMainBranchResource,
+		NewProjectBadgeTokenResource,
 		NewUserTokenResource,
 		NewQualityGateResource,
 		NewQualityGateSelectionResource,
+		NewQualityGatePermissionsResource,
+		NewProjectQualityGateResource,
+		NewDefaultQualityGateResource,
 		NewUserPermissionsResource,
 		NewUserGroupPermissionsResource,
 		NewWebhookResource,
+		NewOrganizationMemberResource,
+		NewOrganizationResource,
 	}
 }
 
@@ -116,6 +149,8 @@ func (p *sonarcloudProvider) DataSources(ctx context.Context) []func() datasourc
 	return []func() datasource.DataSource{
 		NewProjectsDataSource,
 		NewProjectLinksDataSource,
+		NewProjectBadgeDataSource,
+		NewProjectMeasuresDataSource,
 		NewUserGroupDataSource,
 		NewUserGroupsDataSource,
 		NewUserGroupMembersDataSource,
@@ -123,6 +158,18 @@ func (p *sonarcloudProvider) DataSources(ctx context.Context) []func() datasourc
 		NewUserPermissionsDataSource,
 		NewQualityGateDataSource,
 		NewQualityGatesDataSource,
+		NewQualityGateStatusDataSource,
+		NewMetricsDataSource,
 		NewWebhooksDataSource,
+		NewOrganizationMembersDataSource,
+		NewOrganizationDataSource,
+		NewUserTokensDataSource,
+		NewWebhookDeliveriesDataSource,
+	}
+}
+
+func (p *sonarcloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewUserTokenEphemeralResource,
 	}
 }
@@ -0,0 +1,185 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/user_groups"
+)
+
+// builtInDefaultGroup is the group that SonarCloud creates for every organization and uses as default group
+const builtInDefaultGroup = "Members"
+
+type DefaultGroupResource struct {
+	p *sonarcloudProvider
+}
+
+func NewDefaultGroupResource() resource.Resource {
+	return &DefaultGroupResource{}
+}
+
+func (*DefaultGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_group"
+}
+
+func (d *DefaultGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r DefaultGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource manages the default group of the organization, to which new members are added automatically." +
+			" There should be at most one instance of this resource per organization." +
+			" On destroy, the built-in `" + builtInDefaultGroup + "` group is restored as the default group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key of the organization.",
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the group that new members of the organization are added to.",
+			},
+		},
+	}
+}
+
+func (r DefaultGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan DefaultGroup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefault(plan.Group.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not set the default group",
+			fmt.Sprintf("The SetDefault request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DefaultGroup{
+		ID:    types.StringValue(r.p.organization),
+		Group: plan.Group,
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r DefaultGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state DefaultGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := user_groups.SearchRequest{}
+
+	response, err := r.p.client.UserGroups.SearchAll(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the default group",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	// Check if there is a default group in the list of retrieved resources
+	if group, ok := findDefaultGroup(response); ok {
+		result := DefaultGroup{
+			ID:    types.StringValue(r.p.organization),
+			Group: group.Name,
+		}
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r DefaultGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan DefaultGroup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefault(plan.Group.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not update the default group",
+			fmt.Sprintf("The SetDefault request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DefaultGroup{
+		ID:    types.StringValue(r.p.organization),
+		Group: plan.Group,
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r DefaultGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restore the built-in default group, an organization always has a default group
+	if err := r.setDefault(builtInDefaultGroup); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not restore the default group",
+			fmt.Sprintf("The SetDefault request for the '%s' group returned an error: %+v", builtInDefaultGroup, err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r DefaultGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDefault makes the group with the given name the default group of the organization
+func (r DefaultGroupResource) setDefault(name string) error {
+	request := user_groups.SetDefaultRequest{
+		Name:         name,
+		Organization: r.p.organization,
+	}
+
+	return r.p.client.UserGroups.SetDefault(request)
+}
@@ -0,0 +1,58 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDefaultGroup(t *testing.T) {
+	group := os.Getenv("SONARCLOUD_TEST_GROUP_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUserGroupMember(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultGroupConfig(group),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_group.test", "group", group),
+					resource.TestCheckResourceAttr("data.sonarcloud_user_groups.test", "default_group", group),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_default_group.test",
+				ImportState:       true,
+				ImportStateId:     os.Getenv("SONARCLOUD_ORGANIZATION"),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultGroupConfig(builtInDefaultGroup),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_group.test", "group", builtInDefaultGroup),
+					resource.TestCheckResourceAttr("data.sonarcloud_user_groups.test", "default_group", builtInDefaultGroup),
+				),
+			},
+		},
+		CheckDestroy: testAccDefaultGroupDestroy,
+	})
+}
+
+func testAccDefaultGroupDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccDefaultGroupConfig(group string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_default_group" "test" {
+	group = "%s"
+}
+
+data "sonarcloud_user_groups" "test" {
+	depends_on = [sonarcloud_default_group.test]
+}
+`, group)
+}
@@ -0,0 +1,180 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+type DefaultQualityGateResource struct {
+	p *sonarcloudProvider
+}
+
+func NewDefaultQualityGateResource() resource.Resource {
+	return &DefaultQualityGateResource{}
+}
+
+func (*DefaultQualityGateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_quality_gate"
+}
+
+func (d *DefaultQualityGateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r DefaultQualityGateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource manages the default quality gate of the organization, which is used by all projects without a selected quality gate." +
+			" There should be at most one instance of this resource per organization, and `is_default` should not be set on any `sonarcloud_quality_gate`." +
+			" On destroy, the built-in quality gate is restored as the default quality gate.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key of the organization.",
+			},
+			"gate_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the quality gate that is the default of the organization.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the default quality gate.",
+			},
+		},
+	}
+}
+
+func (r DefaultQualityGateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan DefaultQualityGate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefault(plan.GateId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not set the default quality gate",
+			fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
+		)
+		return
+	}
+
+	r.readDefault(ctx, &resp.State, &resp.Diagnostics, true)
+}
+
+func (r DefaultQualityGateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.readDefault(ctx, &resp.State, &resp.Diagnostics, false)
+}
+
+func (r DefaultQualityGateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan DefaultQualityGate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefault(plan.GateId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not update the default quality gate",
+			fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
+		)
+		return
+	}
+
+	r.readDefault(ctx, &resp.State, &resp.Diagnostics, true)
+}
+
+func (r DefaultQualityGateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restore the built-in quality gate, an organization always has a default quality gate
+	resp.Diagnostics.Append(resetDefaultQualityGate(r.p)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r DefaultQualityGateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setDefault makes the quality gate with the given ID the default quality gate of the organization
+func (r DefaultQualityGateResource) setDefault(id string) error {
+	request := qualitygates.SetAsDefaultRequest{
+		Id:           id,
+		Organization: r.p.organization,
+	}
+
+	return r.p.client.Qualitygates.SetAsDefault(request)
+}
+
+// readDefault writes the current default quality gate to the state. After a write, a missing default gate is an error,
+// otherwise the resource is removed from the state.
+func (r DefaultQualityGateResource) readDefault(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics, afterWrite bool) {
+	request := qualitygates.ListRequest{
+		Organization: r.p.organization,
+	}
+
+	response, err := r.p.client.Qualitygates.List(request)
+	if err != nil {
+		diags.AddError(
+			"Could not read the default quality gate",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return
+	}
+
+	gate, ok := findDefaultQualityGate(response)
+	if !ok {
+		if afterWrite {
+			diags.AddError(
+				"Could not find the default quality gate",
+				"The default quality gate was set, but the organization has no default quality gate.",
+			)
+		} else {
+			state.RemoveResource(ctx)
+		}
+		return
+	}
+
+	result := DefaultQualityGate{
+		ID:     types.StringValue(r.p.organization),
+		GateId: gate.ID,
+		Name:   gate.Name,
+	}
+	diags.Append(state.Set(ctx, result)...)
+}
@@ -0,0 +1,55 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDefaultQualityGate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultQualityGateConfig("default_quality_gate_test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_default_quality_gate.test", "name", "default_quality_gate_test"),
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_gate.test", "is_default", "true"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_default_quality_gate.test",
+				ImportState:       true,
+				ImportStateId:     os.Getenv("SONARCLOUD_ORGANIZATION"),
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccDefaultQualityGateDestroy,
+	})
+}
+
+func testAccDefaultQualityGateDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccDefaultQualityGateConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "%s"
+}
+
+resource "sonarcloud_default_quality_gate" "test" {
+	gate_id = sonarcloud_quality_gate.test.gate_id
+}
+
+data "sonarcloud_quality_gate" "test" {
+	name = sonarcloud_quality_gate.test.name
+
+	depends_on = [sonarcloud_default_quality_gate.test]
+}
+`, name)
+}
@@ -0,0 +1,324 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/organizations"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/permissions"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/projects"
+)
+
+type OrganizationResource struct {
+	p *sonarcloudProvider
+}
+
+func NewOrganizationResource() resource.Resource {
+	return &OrganizationResource{}
+}
+
+func (*OrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *OrganizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r OrganizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource manages the settings of the configured organization. There should be at most one instance of this resource per organization." +
+			" Settings that are not configured are left as they are. The organization is not deleted on destroy, its settings are kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The display name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The description of the organization. Cannot be empty, removing it from the configuration leaves the current value.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The URL of the website of the organization. Cannot be empty, removing it from the configuration leaves the current value.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"avatar": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The URL of the avatar of the organization. Cannot be empty, removing it from the configuration leaves the current value.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_visibility": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The visibility of new projects, either `public` or `private`." +
+					" **Note:** private projects are only available when you have a SonarCloud subscription.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private"),
+				},
+			},
+			"default_permission_template": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the permission template that grants the default permissions on new projects.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan Organization
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The organization already exists, so creating the resource adopts it and applies the configured settings
+	current, err := r.readSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization",
+			fmt.Sprintf("Reading the organization returned an error: %+v", err),
+		)
+		return
+	}
+
+	r.applySettings(*current, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.readSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization",
+			fmt.Sprintf("Reading the organization returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	result, err := r.readSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization",
+			fmt.Sprintf("Reading the organization returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from state
+	var state Organization
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan Organization
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applySettings(state, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.readSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization",
+			fmt.Sprintf("Reading the organization returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The organization cannot be deleted by this provider, so it is only removed from the state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != r.p.organization {
+		resp.Diagnostics.AddError(
+			"Unexpected organization",
+			fmt.Sprintf("Only the configured organization '%s' can be imported, got '%s'.", r.p.organization, req.ID),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applySettings updates the settings that differ between the current settings and the plan.
+// Settings that are not configured are unknown or kept from the state, so they are left untouched.
+func (r OrganizationResource) applySettings(current, plan Organization, diags *diag.Diagnostics) {
+	request := organizations.UpdateRequest{
+		Key: r.p.organization,
+	}
+	if settingChanged(current.Name, plan.Name) {
+		request.Name = plan.Name.ValueString()
+	}
+	if settingChanged(current.Description, plan.Description) {
+		request.Description = plan.Description.ValueString()
+	}
+	if settingChanged(current.Url, plan.Url) {
+		request.Url = plan.Url.ValueString()
+	}
+	if settingChanged(current.Avatar, plan.Avatar) {
+		request.Avatar = plan.Avatar.ValueString()
+	}
+
+	if request != (organizations.UpdateRequest{Key: r.p.organization}) {
+		if err := r.p.client.Organizations.Update(request); err != nil {
+			diags.AddError(
+				"Could not update the organization",
+				fmt.Sprintf("The Update request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
+	if settingChanged(current.DefaultVisibility, plan.DefaultVisibility) {
+		request := projects.UpdateDefaultVisibilityRequest{
+			Organization:      r.p.organization,
+			ProjectVisibility: plan.DefaultVisibility.ValueString(),
+		}
+		if err := r.p.client.Projects.UpdateDefaultVisibility(request); err != nil {
+			diags.AddError(
+				"Could not update the default visibility of the organization",
+				fmt.Sprintf("The UpdateDefaultVisibility request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
+	// An organization without a default template has an empty name, which cannot be set as default
+	if settingChanged(current.DefaultPermissionTemplate, plan.DefaultPermissionTemplate) && plan.DefaultPermissionTemplate.ValueString() != "" {
+		request := permissions.SetDefaultTemplateRequest{
+			Organization: r.p.organization,
+			Qualifier:    "TRK",
+			TemplateName: plan.DefaultPermissionTemplate.ValueString(),
+		}
+		if err := r.p.client.Permissions.SetDefaultTemplate(request); err != nil {
+			diags.AddError(
+				"Could not update the default permission template of the organization",
+				fmt.Sprintf("The SetDefaultTemplate request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+}
+
+// settingChanged returns whether a setting is configured in the plan with a value that differs from the current one
+func settingChanged(current, planned types.String) bool {
+	return !planned.IsUnknown() && !planned.IsNull() && !planned.Equal(current)
+}
+
+// readSettings returns the current settings of the configured organization
+func (r OrganizationResource) readSettings() (*Organization, error) {
+	org, err := readOrganization(r.p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Organization{
+		ID:                        org.ID,
+		Name:                      org.Name,
+		Description:               org.Description,
+		Url:                       org.Url,
+		Avatar:                    org.Avatar,
+		DefaultVisibility:         org.DefaultVisibility,
+		DefaultPermissionTemplate: org.DefaultPermissionTemplate,
+	}, nil
+}
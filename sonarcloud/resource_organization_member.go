@@ -0,0 +1,192 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/organizations"
+)
+
+type OrganizationMemberResource struct {
+	p *sonarcloudProvider
+}
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+func (*OrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (d *OrganizationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r OrganizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource manages a single member of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"login": schema.StringAttribute{
+				Required:    true,
+				Description: "The login of the user that should be added to the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the user.",
+			},
+		},
+	}
+}
+
+func (r OrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan OrganizationMember
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := organizations.AddMemberRequest{
+		Login:        plan.Login.ValueString(),
+		Organization: r.p.organization,
+	}
+
+	_, err := r.p.client.Organizations.AddMember(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not create the organization_member",
+			fmt.Sprintf("The AddMember request returned an error: %+v", err),
+		)
+		return
+	}
+
+	// Query the member again, the name is not always part of the response
+	searchRequest := organizations.SearchMembersRequest{
+		Organization: r.p.organization,
+		Q:            plan.Login.ValueString(),
+	}
+
+	response, err := r.p.client.Organizations.SearchMembersAll(searchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization_member",
+			fmt.Sprintf("The SearchMembersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	if result, ok := findOrganizationMember(response, plan.Login.ValueString()); ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.AddError(
+			"Could not find the organization_member",
+			fmt.Sprintf("The user '%s' was added, but could not be found in the members of the organization.", plan.Login.ValueString()),
+		)
+	}
+}
+
+func (r OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state OrganizationMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := organizations.SearchMembersRequest{
+		Organization: r.p.organization,
+		Q:            state.Login.ValueString(),
+	}
+
+	response, err := r.p.client.Organizations.SearchMembersAll(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the organization_member",
+			fmt.Sprintf("The SearchMembersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	// Check if the resource exists the list of retrieved resources
+	if result, ok := findOrganizationMember(response, state.Login.ValueString()); ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r OrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// NOOP, we always need to recreate
+}
+
+func (r OrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state OrganizationMember
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in api action struct
+	request := organizations.RemoveMemberRequest{
+		Login:        state.Login.ValueString(),
+		Organization: r.p.organization,
+	}
+
+	err := r.p.client.Organizations.RemoveMember(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not delete the organization_member",
+			fmt.Sprintf("The RemoveMember request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r OrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("login"), req, resp)
+}
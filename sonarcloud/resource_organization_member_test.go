@@ -0,0 +1,57 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckOrganizationMember(t *testing.T) {
+	if v := os.Getenv("SONARCLOUD_TEST_MEMBER_LOGIN"); v == "" {
+		t.Fatal("SONARCLOUD_TEST_MEMBER_LOGIN must be set for acceptance tests")
+	}
+}
+
+func TestAccOrganizationMember(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TEST_MEMBER_LOGIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckOrganizationMember(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMemberConfig(login),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_organization_member.test_member", "login", login),
+					resource.TestCheckResourceAttrSet("sonarcloud_organization_member.test_member", "name"),
+				),
+			},
+			organizationMemberImportCheck("sonarcloud_organization_member.test_member", login),
+		},
+		CheckDestroy: testAccOrganizationMemberDestroy,
+	})
+}
+
+func testAccOrganizationMemberDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccOrganizationMemberConfig(login string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_organization_member" "test_member" {
+	login = "%s"
+}
+`, login)
+}
+
+func organizationMemberImportCheck(resourceName, login string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     login,
+		ImportStateVerify: true,
+	}
+}
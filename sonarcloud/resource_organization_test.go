@@ -0,0 +1,99 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/kauppine/go-sonarcloud/sonarcloud"
+)
+
+func TestAccOrganization(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationRestore(t)
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfig("Managed by Terraform", "https://www.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_organization.test", "id", organization),
+					resource.TestCheckResourceAttr("sonarcloud_organization.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("sonarcloud_organization.test", "url", "https://www.example.com"),
+					resource.TestCheckResourceAttr("sonarcloud_organization.test", "default_visibility", "public"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_organization.test",
+				ImportState:       true,
+				ImportStateId:     organization,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "sonarcloud_organization.test",
+				ImportState:   true,
+				ImportStateId: "another-organization",
+				ExpectError:   regexp.MustCompile("Unexpected organization"),
+			},
+			{
+				Config: testAccOrganizationConfig("Still managed by Terraform", "https://www.example.com/test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_organization.test", "description", "Still managed by Terraform"),
+					resource.TestCheckResourceAttr("sonarcloud_organization.test", "url", "https://www.example.com/test"),
+				),
+			},
+		},
+		CheckDestroy: testAccOrganizationDestroy,
+	})
+}
+
+// testAccOrganizationRestore puts the settings of the shared test organization back once the test is done,
+// as destroying the resource leaves the organization as it is
+func testAccOrganizationRestore(t *testing.T) {
+	r := OrganizationResource{p: &sonarcloudProvider{
+		client:       sonarcloud.NewClient(os.Getenv("SONARCLOUD_ORGANIZATION"), os.Getenv("SONARCLOUD_TOKEN"), nil),
+		organization: os.Getenv("SONARCLOUD_ORGANIZATION"),
+		configured:   true,
+	}}
+
+	original, err := r.readSettings()
+	if err != nil {
+		t.Fatalf("could not read the settings of the organization: %+v", err)
+	}
+
+	t.Cleanup(func() {
+		current, err := r.readSettings()
+		if err != nil {
+			t.Errorf("could not read the settings of the organization: %+v", err)
+			return
+		}
+
+		var diags diag.Diagnostics
+		r.applySettings(*current, *original, &diags)
+		if diags.HasError() {
+			t.Errorf("could not restore the settings of the organization: %+v", diags)
+		}
+	})
+}
+
+func testAccOrganizationDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccOrganizationConfig(description, url string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_organization" "test" {
+	description        = "%s"
+	url                = "%s"
+	default_visibility = "public"
+}
+`, description, url)
+}
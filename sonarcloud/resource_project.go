@@ -75,8 +75,12 @@ func (r ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional: true,
 				Computed: true,
 				Description: "The visibility of the project. Use `private` to only share it with your organization." +
-					" Use `public` if the project should be visible to everyone. Defaults to the organization's default visibility." +
-					" **Note:** private projects are only available when you have a SonarCloud subscription.",
+					" Use `public` if the project should be visible to everyone. Defaults to the organization's default visibility, which can be managed with the `default_visibility` of `sonarcloud_organization`." +
+					" **Note:** private projects are only available when you have a SonarCloud subscription, this is checked during plan." +
+					" Every change of the visibility of an existing project, in either direction, shows a warning in the plan.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("public", "private"),
 				},
@@ -85,6 +89,75 @@ func (r ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan checks that the subscription plan of the organization allows private projects,
+// and warns about every visibility change of an existing project
+func (r ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or without a client to look up the organization
+	if req.Plan.Raw.IsNull() || r.p == nil || !r.p.configured {
+		return
+	}
+
+	var plan Project
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Visibility.IsUnknown() || plan.Visibility.IsNull() {
+		return
+	}
+
+	var current string
+	if !req.State.Raw.IsNull() {
+		var state Project
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current = state.Visibility.ValueString()
+	}
+
+	visibility := plan.Visibility.ValueString()
+	if visibility == current {
+		return
+	}
+
+	if visibility == "private" {
+		org, err := readOrganization(r.p)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not validate the project visibility",
+				fmt.Sprintf("Reading the organization returned an error, the visibility will be validated on apply: %+v", err),
+			)
+		} else if org.PrivateProjectsAllowed.Equal(types.BoolValue(false)) {
+			// Only the subscription plan is a hard limit
+			resp.Diagnostics.AddAttributeError(
+				path.Root("visibility"),
+				"Private projects are not allowed",
+				fmt.Sprintf("The subscription plan '%s' of the organization '%s' does not allow private projects. Use the 'public' visibility, or upgrade the subscription of the organization.",
+					org.Subscription.ValueString(), r.p.organization),
+			)
+			return
+		} else if !org.CanMakeProjectsPrivate.ValueBool() {
+			// The permissions of the token can only be checked by SonarCloud itself
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("visibility"),
+				"Private projects might not be allowed for this token",
+				fmt.Sprintf("SonarCloud reports that the token of the provider cannot make projects of the organization '%s' private, the change might fail on apply."+
+					" Check that the token has the permission to administer the organization.", r.p.organization),
+			)
+		}
+	}
+
+	// Changing the visibility of an existing project is not a harmless toggle, so every change in either direction stands out in the plan.
+	// This covers flipping a project back to public after it was made private.
+	if current != "" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("visibility"),
+			"Project visibility change",
+			visibilityChangeWarning(plan.Key.ValueString(), current, visibility),
+		)
+	}
+}
+
 func (r ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
@@ -124,8 +197,36 @@ func (r ProjectResource) Create(ctx context.Context, req resource.CreateRequest,
 		ID:         types.StringValue(res.Project.Key),
 		Name:       types.StringValue(res.Project.Name),
 		Key:        types.StringValue(res.Project.Key),
-		Visibility: types.StringValue(plan.Visibility.ValueString()),
+		Visibility: types.StringValue(res.Project.Visibility),
+	}
+
+	// Without a configured visibility the project gets the default visibility of the organization,
+	// look it up when the response does not include it
+	if res.Project.Visibility == "" {
+		searchRequest := projects.SearchRequest{
+			Projects: res.Project.Key,
+		}
+
+		response, err := r.p.client.Projects.SearchAll(searchRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read the project",
+				fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+			)
+			return
+		}
+
+		project, ok := findProject(response, res.Project.Key)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Could not find the project",
+				fmt.Sprintf("The project '%s' was created, but could not be found afterwards.", res.Project.Key),
+			)
+			return
+		}
+		result.Visibility = project.Visibility
 	}
+
 	diags = resp.State.Set(ctx, result)
 
 	resp.Diagnostics.Append(diags...)
@@ -265,3 +366,13 @@ func (r ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
 }
+
+// visibilityChangeWarning explains the consequences of changing the visibility of an existing project
+func visibilityChangeWarning(key, from, to string) string {
+	if to == "public" {
+		return fmt.Sprintf("The project '%s' changes from %s to public. Its source code, issues and measures become visible to everyone, including anonymous users."+
+			" Making it private again later does not undo what has already been exposed.", key, from)
+	}
+	return fmt.Sprintf("The project '%s' changes from %s to private. Only members of the organization can browse it afterwards, badges and links shared outside of the organization stop working."+
+		" Making it public again later exposes its source code, issues and measures to everyone.", key, from)
+}
@@ -0,0 +1,177 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/project_badges"
+)
+
+type ProjectBadgeTokenResource struct {
+	p *sonarcloudProvider
+}
+
+func NewProjectBadgeTokenResource() resource.Resource {
+	return &ProjectBadgeTokenResource{}
+}
+
+func (*ProjectBadgeTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_badge_token"
+}
+
+func (d *ProjectBadgeTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r ProjectBadgeTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource renews the badge token of a project when it is created. Change `triggers` to renew the token again." +
+			" **Warning:** renewing the token invalidates all badge urls that use the previous token." +
+			" Destroying this resource has no effect, the current token stays valid.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values that renew the token when changed.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The badge token of the project.",
+			},
+		},
+	}
+}
+
+func (r ProjectBadgeTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectBadgeToken
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := project_badges.RenewTokenRequest{
+		Project: plan.Project.ValueString(),
+	}
+
+	err := r.p.client.ProjectBadges.RenewToken(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not renew the project_badge_token",
+			fmt.Sprintf("The RenewToken request returned an error: %+v", err),
+		)
+		return
+	}
+
+	token, err := r.readToken(plan.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project_badge_token",
+			fmt.Sprintf("The Token request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := ProjectBadgeToken{
+		ID:       plan.Project,
+		Project:  plan.Project,
+		Triggers: plan.Triggers,
+		Token:    types.StringValue(token),
+	}
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ProjectBadgeTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state ProjectBadgeToken
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A token renewed outside of Terraform is picked up, reading the token never renews it
+	token, err := r.readToken(state.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project_badge_token",
+			fmt.Sprintf("The Token request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("token"), types.StringValue(token))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ProjectBadgeTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// NOOP, we always need to recreate
+}
+
+func (r ProjectBadgeTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The token cannot be deleted, so it is only removed from the state
+	resp.State.RemoveResource(ctx)
+}
+
+// readToken returns the current badge token of the project
+func (r ProjectBadgeTokenResource) readToken(project string) (string, error) {
+	request := project_badges.TokenRequest{
+		Project: project,
+	}
+
+	response, err := r.p.client.ProjectBadges.Token(request)
+	if err != nil {
+		return "", err
+	}
+	return response.Token, nil
+}
@@ -0,0 +1,51 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceProjectBadgeToken(t *testing.T) {
+	project := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectBadgeTokenConfig(project, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_badge_token.test", "project", project),
+					resource.TestCheckResourceAttrSet("sonarcloud_project_badge_token.test", "token"),
+					resource.TestCheckResourceAttrPair("sonarcloud_project_badge_token.test", "token", "data.sonarcloud_project_badge.test", "token"),
+				),
+			},
+			{
+				Config: testAccResourceProjectBadgeTokenConfig(project, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_badge_token.test", "triggers.rotation", "2"),
+					resource.TestCheckResourceAttrPair("sonarcloud_project_badge_token.test", "token", "data.sonarcloud_project_badge.test", "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceProjectBadgeTokenConfig(project, rotation string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_badge_token" "test" {
+  project = "%s"
+
+  triggers = {
+    rotation = "%s"
+  }
+}
+
+data "sonarcloud_project_badge" "test" {
+  project = sonarcloud_project_badge_token.test.project
+}
+`, project, rotation)
+}
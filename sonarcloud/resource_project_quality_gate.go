@@ -0,0 +1,253 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/projects"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+type ProjectQualityGateResource struct {
+	p *sonarcloudProvider
+}
+
+func NewProjectQualityGateResource() resource.Resource {
+	return &ProjectQualityGateResource{}
+}
+
+func (*ProjectQualityGateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_quality_gate"
+}
+
+func (d *ProjectQualityGateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r ProjectQualityGateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource selects the quality gate of a single project. Changing the quality gate moves the project to the new gate in place," +
+			" on destroy the project falls back to the default quality gate of the organization." +
+			" **Warning:** do not combine this resource with `sonarcloud_quality_gate_selection` for the same project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The key of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gate_id": schema.StringAttribute{
+				Description: "The ID of the quality gate that is selected for the project.",
+				Required:    true,
+			},
+			"gate_name": schema.StringAttribute{
+				Description: "The name of the quality gate that is selected for the project.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r ProjectQualityGateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectQualityGate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.selectGate(plan.ProjectKey.ValueString(), plan.GateId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not select the project_quality_gate",
+			fmt.Sprintf("The Select request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result, ok := r.readGate(plan.ProjectKey.ValueString(), &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the project",
+				fmt.Sprintf("The project '%s' does not exist.", plan.ProjectKey.ValueString()),
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ProjectQualityGateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state ProjectQualityGate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A gate selected outside of Terraform shows up as drift of the gate_id
+	result, ok := r.readGate(state.ProjectKey.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r ProjectQualityGateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ProjectQualityGate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Selecting another gate moves the project, there is no need to deselect the current gate first
+	if err := r.selectGate(plan.ProjectKey.ValueString(), plan.GateId.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not update the project_quality_gate",
+			fmt.Sprintf("The Select request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result, ok := r.readGate(plan.ProjectKey.ValueString(), &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the project",
+				fmt.Sprintf("The project '%s' does not exist.", plan.ProjectKey.ValueString()),
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ProjectQualityGateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ProjectQualityGate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := qualitygates.DeselectRequest{
+		Organization: r.p.organization,
+		ProjectKey:   state.ProjectKey.ValueString(),
+	}
+	if err := r.p.client.Qualitygates.Deselect(request); err != nil {
+		resp.Diagnostics.AddError(
+			"Could not deselect the project_quality_gate",
+			fmt.Sprintf("The Deselect request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r ProjectQualityGateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
+
+// selectGate selects the quality gate with the given ID for the project
+func (r ProjectQualityGateResource) selectGate(projectKey, gateId string) error {
+	request := qualitygates.SelectRequest{
+		GateId:       gateId,
+		Organization: r.p.organization,
+		ProjectKey:   projectKey,
+	}
+
+	return r.p.client.Qualitygates.Select(request)
+}
+
+// readGate returns the quality gate that is currently used by the project, or false if the project does not exist
+func (r ProjectQualityGateResource) readGate(projectKey string, diags *diag.Diagnostics) (*ProjectQualityGate, bool) {
+	searchRequest := projects.SearchRequest{
+		Projects: projectKey,
+	}
+
+	projectsResponse, err := r.p.client.Projects.SearchAll(searchRequest)
+	if err != nil {
+		diags.AddError(
+			"Could not read the project",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return nil, false
+	}
+	if _, ok := findProject(projectsResponse, projectKey); !ok {
+		return nil, false
+	}
+
+	request := qualitygates.GetByProjectRequest{
+		Organization: r.p.organization,
+		Project:      projectKey,
+	}
+
+	response, err := r.p.client.Qualitygates.GetByProject(request)
+	if err != nil {
+		diags.AddError(
+			"Could not read the project_quality_gate",
+			fmt.Sprintf("The GetByProject request returned an error: %+v", err),
+		)
+		return nil, false
+	}
+
+	return &ProjectQualityGate{
+		ID:         types.StringValue(projectKey),
+		ProjectKey: types.StringValue(projectKey),
+		GateId:     types.StringValue(fmt.Sprintf("%d", int(response.QualityGate.Id))),
+		GateName:   types.StringValue(response.QualityGate.Name),
+	}, true
+}
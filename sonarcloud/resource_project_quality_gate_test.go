@@ -0,0 +1,61 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectQualityGate(t *testing.T) {
+	gateId := os.Getenv("SONARCLOUD_QUALITY_GATE_ID")
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckQualityGateSelection(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectQualityGateConfig(projectKey, fmt.Sprintf("%q", gateId)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_quality_gate.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_quality_gate.test", "gate_id", gateId),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_project_quality_gate.test",
+				ImportState:       true,
+				ImportStateId:     projectKey,
+				ImportStateVerify: true,
+			},
+			{
+				// Moving the project to another gate is an in-place update
+				Config: testAccProjectQualityGateConfig(projectKey, "sonarcloud_quality_gate.test.gate_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarcloud_project_quality_gate.test", "gate_id", "sonarcloud_quality_gate.test", "gate_id"),
+					resource.TestCheckResourceAttr("sonarcloud_project_quality_gate.test", "gate_name", "project_quality_gate_test"),
+				),
+			},
+		},
+		CheckDestroy: testAccProjectQualityGateDestroy,
+	})
+}
+
+func testAccProjectQualityGateDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccProjectQualityGateConfig(projectKey, gateId string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "project_quality_gate_test"
+}
+
+resource "sonarcloud_project_quality_gate" "test" {
+	project_key = "%s"
+	gate_id     = %s
+}
+`, projectKey, gateId)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
 	"testing"
 )
 
@@ -52,6 +53,30 @@ func TestAccResourceProject(t *testing.T) {
 	})
 }
 
+func TestAccResourceProjectVisibility(t *testing.T) {
+	key := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) + "sonarcloud-provider-acc-test"
+
+	// The acceptance test organization is on the free plan, so it only allows public projects
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigDefaultVisibility("project_visibility", key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "key", key),
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "visibility", "public"),
+				),
+			},
+			{
+				Config:      testAccProjectConfig("project_visibility", key, "private"),
+				ExpectError: regexp.MustCompile("Private projects are not allowed"),
+			},
+		},
+		CheckDestroy: testAccProjectDestroy,
+	})
+}
+
 func testAccProjectDestroy(s *terraform.State) error {
 	return nil
 }
@@ -66,6 +91,15 @@ resource "sonarcloud_project" "test" {
 `, name, key, visibility)
 }
 
+func testAccProjectConfigDefaultVisibility(name, key string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project" "test" {
+	name = "%s"
+	key = "%s"
+}
+`, name, key)
+}
+
 func projectImportCheck(resourceName, key string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      resourceName,
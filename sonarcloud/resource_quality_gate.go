@@ -3,14 +3,17 @@ package sonarcloud
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -66,6 +69,15 @@ func (r QualityGateResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Name of the Quality Gate.",
 				Required:    true,
 			},
+			"copy_from": schema.StringAttribute{
+				Description: "The name or ID of an existing Quality Gate to copy, e.g. the built-in `Sonar way`. The copy inherits all conditions of that gate." +
+					" The `conditions` then only manage the listed metrics: they are updated or added on top of the inherited conditions, all other inherited conditions are left untouched." +
+					" Changing this value creates a new Quality Gate.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"is_built_in": schema.BoolAttribute{
 				Description: "Defines whether the quality gate is built in.",
 				Computed:    true,
@@ -74,7 +86,7 @@ func (r QualityGateResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"is_default": schema.BoolAttribute{
-				Description: "Defines whether the quality gate is the default gate for an organization. **WARNING**: Must be assigned to one quality gate per organization at all times.",
+				Description: "Defines whether the quality gate is the default gate for an organization. Set it to `false` to make the built-in quality gate the default again, removing the attribute from the configuration leaves the default as it is. When the default gate is destroyed, the built-in quality gate becomes the default again. Prefer `sonarcloud_default_quality_gate` to manage the default in a single place.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Bool{
@@ -82,8 +94,9 @@ func (r QualityGateResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"conditions": schema.SetNestedAttribute{
-				Optional:    true,
-				Description: "The conditions of this quality gate. Please query https://sonarcloud.io/api/metrics/search for an up-to-date list of conditions.",
+				Optional: true,
+				Description: "The conditions of this quality gate. Metrics that do not exist are rejected during plan, and metrics that are likely not allowed in quality gates show a warning." +
+					" See the `sonarcloud_metrics` data source for all metrics.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Float64Attribute{
@@ -95,8 +108,10 @@ func (r QualityGateResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Required:    true,
 						},
 						"op": schema.StringAttribute{
-							Description: "Operation on which the metric is evaluated must be either: LT, GT.",
-							Optional:    true,
+							Description: "Operation on which the metric is evaluated must be either: LT, GT." +
+								" Existing conditions keep their operator when it is omitted, new conditions default to LT for metrics where a higher value is better (e.g. `coverage`) and to GT for all other metrics.",
+							Optional: true,
+							Computed: true,
 							Validators: []validator.String{
 								stringvalidator.OneOf("LT", "GT"),
 							},
@@ -112,6 +127,101 @@ func (r QualityGateResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// ModifyPlan validates the metrics of the conditions against the metrics catalog of SonarCloud
+// and fills in the default operator of new conditions
+func (r QualityGateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or without a client to look up the metrics
+	if req.Plan.Raw.IsNull() || r.p == nil || !r.p.configured {
+		return
+	}
+
+	var conditions []Condition
+	diags := req.Config.GetAttribute(ctx, path.Root("conditions"), &conditions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(conditions) == 0 {
+		return
+	}
+
+	catalog, err := r.p.metricsCatalog()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not validate the condition metrics",
+			fmt.Sprintf("The metrics SearchAll request returned an error, the metrics will be validated on apply: %+v", err),
+		)
+		return
+	}
+
+	allowed := make(map[string]bool, len(catalog.Metrics))
+	directions := make(map[string]int, len(catalog.Metrics))
+	for _, metric := range catalog.Metrics {
+		allowed[metric.Key] = metricAllowedInGates(metric.Key, metric.Type, metric.Hidden)
+		directions[metric.Key] = int(metric.Direction)
+	}
+
+	for _, condition := range conditions {
+		if condition.Metric.IsUnknown() || condition.Metric.IsNull() {
+			continue
+		}
+
+		metric := condition.Metric.ValueString()
+		if isAllowed, ok := allowed[metric]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("conditions"),
+				"Unknown condition metric",
+				fmt.Sprintf("The metric '%s' does not exist. See the sonarcloud_metrics data source for all available metrics.", metric),
+			)
+		} else if !isAllowed {
+			// Whether a metric is allowed in gates is not part of the metrics catalog, so this is only a warning
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("conditions"),
+				"Condition metric is probably not allowed in quality gates",
+				fmt.Sprintf("The metric '%s' is likely rejected by SonarCloud in a quality gate condition, based on its type and the metrics known to be excluded."+
+					" SonarCloud validates the condition on apply.", metric),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Conditions without an operator would otherwise show the operator filled in by SonarCloud as a change on the next plan.
+	// Existing conditions keep the operator from the state, only new conditions get the default.
+	var planned []Condition
+	diags = req.Plan.GetAttribute(ctx, path.Root("conditions"), &planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []Condition
+	if !req.State.Raw.IsNull() {
+		diags = req.State.GetAttribute(ctx, path.Root("conditions"), &current)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	matched := matchConditions(current, planned)
+
+	normalized := false
+	for i, condition := range planned {
+		if !condition.Op.IsUnknown() && !condition.Op.IsNull() {
+			continue
+		}
+		if j := matched[i]; j >= 0 {
+			planned[i].Op = current[j].Op
+			normalized = true
+		} else if direction, ok := directions[condition.Metric.ValueString()]; ok {
+			planned[i].Op = types.StringValue(defaultConditionOp(direction))
+			normalized = true
+		}
+	}
+	if normalized {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("conditions"), planned)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
 func (r QualityGateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
@@ -130,26 +240,45 @@ func (r QualityGateResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Fill in api action struct for Quality Gates
-	request := qualitygates.CreateRequest{
-		Name:         plan.Name.ValueString(),
-		Organization: r.p.organization,
-	}
+	var result QualityGate
+	// The conditions of a copied Quality Gate
+	var inherited []Condition
 
-	res, err := r.p.client.Qualitygates.Create(request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Could not create the Quality Gate",
-			fmt.Sprintf("The Quality Gate create request returned an error: %+v", err),
-		)
-		return
-	}
+	if plan.CopyFrom.IsNull() {
+		// Fill in api action struct for Quality Gates
+		request := qualitygates.CreateRequest{
+			Name:         plan.Name.ValueString(),
+			Organization: r.p.organization,
+		}
 
-	var result = QualityGate{
-		ID:     types.StringValue(fmt.Sprintf("%d", int(res.Id))),
-		GateId: types.Float64Value(res.Id),
-		Name:   types.StringValue(res.Name),
+		res, err := r.p.client.Qualitygates.Create(request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not create the Quality Gate",
+				fmt.Sprintf("The Quality Gate create request returned an error: %+v", err),
+			)
+			return
+		}
+
+		result = QualityGate{
+			ID:     types.StringValue(fmt.Sprintf("%d", int(res.Id))),
+			GateId: types.Float64Value(res.Id),
+			Name:   types.StringValue(res.Name),
+		}
+	} else {
+		copied, ok := r.copyQualityGate(plan.CopyFrom.ValueString(), plan.Name.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		result = QualityGate{
+			ID:     copied.ID,
+			GateId: copied.GateId,
+			Name:   copied.Name,
+		}
+		inherited = copied.Conditions
 	}
+	result.CopyFrom = plan.CopyFrom
 
 	if plan.IsDefault.ValueBool() {
 		setDefualtRequest := qualitygates.SetAsDefaultRequest{
@@ -166,7 +295,31 @@ func (r QualityGateResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	conditionRequests := qualitygates.CreateConditionRequest{}
-	for _, conditionPlan := range plan.Conditions {
+	matched := matchConditions(inherited, plan.Conditions)
+	for i, conditionPlan := range plan.Conditions {
+		// Conditions of a copied gate are updated on top of the inherited ones
+		if j := matched[i]; j >= 0 {
+			c := inherited[j]
+			if !conditionChanged(c, conditionPlan) {
+				continue
+			}
+			request := qualitygates.UpdateConditionRequest{
+				Error:        conditionPlan.Error.ValueString(),
+				Id:           fmt.Sprintf("%d", int(c.ID.ValueFloat64())),
+				Metric:       conditionPlan.Metric.ValueString(),
+				Op:           conditionPlan.Op.ValueString(),
+				Organization: r.p.organization,
+			}
+			if err := r.p.client.Qualitygates.UpdateCondition(request); err != nil {
+				resp.Diagnostics.AddError(
+					"Could not update an inherited Condition",
+					fmt.Sprintf("The UpdateCondition request returned an error: %+v", err),
+				)
+				return
+			}
+			continue
+		}
+
 		conditionRequests = qualitygates.CreateConditionRequest{
 			Error:        conditionPlan.Error.ValueString(),
 			GateId:       fmt.Sprintf("%d", int(result.GateId.ValueFloat64())),
@@ -174,7 +327,7 @@ func (r QualityGateResource) Create(ctx context.Context, req resource.CreateRequ
 			Op:           conditionPlan.Op.ValueString(),
 			Organization: r.p.organization,
 		}
-		res, err := r.p.client.Qualitygates.CreateCondition(conditionRequests)
+		_, err := r.p.client.Qualitygates.CreateCondition(conditionRequests)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Could not create a Condition",
@@ -182,16 +335,9 @@ func (r QualityGateResource) Create(ctx context.Context, req resource.CreateRequ
 			)
 			return
 		}
-		// didn't implement warning
-		result.Conditions = append(result.Conditions, Condition{
-			Error:  types.StringValue(res.Error),
-			ID:     types.Float64Value(res.Id),
-			Metric: types.StringValue(res.Metric),
-			Op:     types.StringValue(res.Op),
-		})
 	}
 
-	// Actions are not returned with create request, so we need to query for them
+	// Actions and the conditions as stored by SonarCloud are not returned with create request, so we need to query for them
 	listRequest := qualitygates.ListRequest{
 		Organization: r.p.organization,
 	}
@@ -208,6 +354,10 @@ func (r QualityGateResource) Create(ctx context.Context, req resource.CreateRequ
 	if createdQualityGate, ok := findQualityGate(listRes, result.Name.ValueString()); ok {
 		result.IsBuiltIn = createdQualityGate.IsBuiltIn
 		result.IsDefault = createdQualityGate.IsDefault
+		result.Conditions = createdQualityGate.Conditions
+		if !plan.CopyFrom.IsNull() {
+			result.Conditions = filterConditions(result.Conditions, plan.Conditions)
+		}
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -239,6 +389,10 @@ func (r QualityGateResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Check if the resource exists in the list of retrieved resources
 	if result, ok := findQualityGate(response, state.Name.ValueString()); ok {
+		result.CopyFrom = state.CopyFrom
+		if !state.CopyFrom.IsNull() {
+			result.Conditions = filterConditions(result.Conditions, state.Conditions)
+		}
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -300,26 +454,75 @@ func (r QualityGateResource) Update(ctx context.Context, req resource.UpdateRequ
 				return
 			}
 		}
-		// Hard coded default present in all repositories (Sonar way)
-		// This assumes that the Sonar way default quality gate will
-		// never change its ID and remain the default forever.
+		// The built-in gate (Sonar way) takes over as default gate
 		if plan.IsDefault.Equal(types.BoolValue(false)) {
-			request := qualitygates.SetAsDefaultRequest{
-				Id:           "9",
+			resp.Diagnostics.Append(resetDefaultQualityGate(r.p)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	toCreate, toUpdate, toRemove := diffConditions(state.Conditions, plan.Conditions)
+
+	// A copied gate can already have an inherited condition for a metric that was not managed before
+	if !state.CopyFrom.IsNull() && len(toCreate) > 0 {
+		listRequest := qualitygates.ListRequest{
+			Organization: r.p.organization,
+		}
+
+		response, err := r.p.client.Qualitygates.List(listRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read the Quality Gate",
+				fmt.Sprintf("The List request returned an error: %+v", err),
+			)
+			return
+		}
+
+		if current, ok := findQualityGate(response, state.Name.ValueString()); ok {
+			// Only the inherited conditions that are not managed yet can be taken over
+			var unmanaged []Condition
+			for _, c := range current.Conditions {
+				if !slices.ContainsFunc(state.Conditions, func(m Condition) bool { return m.ID.Equal(c.ID) }) {
+					unmanaged = append(unmanaged, c)
+				}
+			}
+
+			var create []Condition
+			matched := matchConditions(unmanaged, toCreate)
+			for i, c := range toCreate {
+				if j := matched[i]; j >= 0 {
+					c.ID = unmanaged[j].ID
+					if c.Op.IsUnknown() || c.Op.IsNull() {
+						c.Op = unmanaged[j].Op
+					}
+					toUpdate = append(toUpdate, c)
+				} else {
+					create = append(create, c)
+				}
+			}
+			toCreate = create
+		}
+	}
+
+	// Remove first, so a condition can be replaced by a new one on the same metric
+	if len(toRemove) > 0 {
+		for _, c := range toRemove {
+			request := qualitygates.DeleteConditionRequest{
+				Id:           fmt.Sprintf("%d", int(c.ID.ValueFloat64())),
 				Organization: r.p.organization,
 			}
-			err := r.p.client.Qualitygates.SetAsDefault(request)
+			err := r.p.client.Qualitygates.DeleteCondition(request)
 			if err != nil {
 				resp.Diagnostics.AddError(
-					"Could not set `Sonar Way` quality gate to default",
-					fmt.Sprintf("The SetAsDefault request returned an error %+v", err),
+					"Could not delete QualityGate condition",
+					fmt.Sprintf("The DeleteCondition request returned an error %+v", err),
 				)
+				return
 			}
 		}
 	}
-
-	toCreate, toUpdate, toRemove := diffConditions(state.Conditions, plan.Conditions)
-
 	if len(toUpdate) > 0 {
 		for _, c := range toUpdate {
 			request := qualitygates.UpdateConditionRequest{
@@ -359,22 +562,6 @@ func (r QualityGateResource) Update(ctx context.Context, req resource.UpdateRequ
 			}
 		}
 	}
-	if len(toRemove) > 0 {
-		for _, c := range toRemove {
-			request := qualitygates.DeleteConditionRequest{
-				Id:           fmt.Sprintf("%d", int(c.ID.ValueFloat64())),
-				Organization: r.p.organization,
-			}
-			err := r.p.client.Qualitygates.DeleteCondition(request)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Could not delete QualityGate condition",
-					fmt.Sprintf("The DeleteCondition request returned an error %+v", err),
-				)
-				return
-			}
-		}
-	}
 	// There aren't any return values for non-create operations.
 	listRequest := qualitygates.ListRequest{
 		Organization: r.p.organization,
@@ -390,6 +577,10 @@ func (r QualityGateResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if result, ok := findQualityGate(response, plan.Name.ValueString()); ok {
+		result.CopyFrom = plan.CopyFrom
+		if !plan.CopyFrom.IsNull() {
+			result.Conditions = filterConditions(result.Conditions, plan.Conditions)
+		}
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	}
@@ -404,20 +595,11 @@ func (r QualityGateResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	// Hard coded default present in all repositories (Sonar way)
-	// This assumes that the Sonar way default quality gate will
-	// never change its ID and remain the default forever.
+	// The default gate cannot be deleted, so the built-in gate (Sonar way) takes over first
 	if state.IsDefault.Equal(types.BoolValue(true)) {
-		request := qualitygates.SetAsDefaultRequest{
-			Id:           "9",
-			Organization: r.p.organization,
-		}
-		err := r.p.client.Qualitygates.SetAsDefault(request)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not reset Organization's default quality gate pre-delete",
-				fmt.Sprintf("The SetAsDefault request returned an error: %+v", err),
-			)
+		resp.Diagnostics.Append(resetDefaultQualityGate(r.p)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -441,6 +623,69 @@ func (r QualityGateResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
+// copyQualityGate copies the Quality Gate with the given name or ID and returns the copy including its conditions
+func (r QualityGateResource) copyQualityGate(source, name string, diags *diag.Diagnostics) (QualityGate, bool) {
+	listRequest := qualitygates.ListRequest{
+		Organization: r.p.organization,
+	}
+
+	response, err := r.p.client.Qualitygates.List(listRequest)
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Gates",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return QualityGate{}, false
+	}
+
+	sourceGate, ok := findQualityGate(response, source)
+	if !ok {
+		sourceGate, ok = findQualityGateByID(response, source)
+	}
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("copy_from"),
+			"Could not find the Quality Gate to copy",
+			fmt.Sprintf("There is no Quality Gate with the name or ID '%s'.", source),
+		)
+		return QualityGate{}, false
+	}
+
+	request := qualitygates.CopyRequest{
+		Id:           sourceGate.ID.ValueString(),
+		Name:         name,
+		Organization: r.p.organization,
+	}
+
+	res, err := r.p.client.Qualitygates.Copy(request)
+	if err != nil {
+		diags.AddError(
+			"Could not copy the Quality Gate",
+			fmt.Sprintf("The Copy request returned an error: %+v", err),
+		)
+		return QualityGate{}, false
+	}
+
+	// The copy response does not contain the conditions, so we need to query for them
+	response, err = r.p.client.Qualitygates.List(listRequest)
+	if err != nil {
+		diags.AddError(
+			"Could not read the copied Quality Gate",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return QualityGate{}, false
+	}
+
+	copied, ok := findQualityGate(response, res.Name)
+	if !ok {
+		diags.AddError(
+			"Could not find the copied Quality Gate",
+			fmt.Sprintf("The Quality Gate '%s' was copied, but could not be found.", res.Name),
+		)
+	}
+	return copied, ok
+}
+
 // Check if quality Gate name is the same
 func diffName(old, new QualityGate) bool {
 	if old.Name.Equal(new.Name) {
@@ -457,34 +702,120 @@ func diffDefault(old, new QualityGate) bool {
 	return true
 }
 
-// Check if Quality Gate Conditions are different
+// diffConditions matches the planned conditions against the conditions in the state, see matchConditions.
+// Matched conditions are only returned for an update if their operator or error changed,
+// and they carry the ID of the condition in the state.
 func diffConditions(old, new []Condition) (create, update, remove []Condition) {
 	create = []Condition{}
 	remove = []Condition{}
 	update = []Condition{}
 
-	for _, c := range new {
-		if !containsCondition(old, c) {
-			create = append(create, c)
-		} else {
-			update = append(update, c)
+	matched := matchConditions(old, new)
+	used := make([]bool, len(old))
+	for i, n := range new {
+		j := matched[i]
+		if j < 0 {
+			create = append(create, n)
+			continue
+		}
+
+		used[j] = true
+		o := old[j]
+		if conditionChanged(o, n) {
+			n.ID = o.ID
+			if n.Op.IsUnknown() || n.Op.IsNull() {
+				n.Op = o.Op
+			}
+			update = append(update, n)
 		}
 	}
-	for _, c := range old {
-		if !containsCondition(new, c) {
-			remove = append(remove, c)
+	for j, o := range old {
+		if !used[j] {
+			remove = append(remove, o)
 		}
 	}
 
 	return create, update, remove
 }
 
-// Check if a condition is contained in a condition list
-func containsCondition(list []Condition, item Condition) bool {
-	for _, c := range list {
-		if c.Metric.Equal(item.Metric) {
-			return true
+// matchConditions pairs every new condition with at most one old condition, so several conditions on the same metric are told apart.
+// A condition is matched by its ID when it is known, otherwise by metric, operator and error, then by metric and operator,
+// then by metric and error, and finally by metric only. It returns the index of the matched old condition for every new
+// condition, or -1 if there is no match.
+func matchConditions(old, new []Condition) []int {
+	matchers := []func(o, n Condition) bool{
+		func(o, n Condition) bool {
+			return !n.ID.IsUnknown() && !n.ID.IsNull() && o.ID.Equal(n.ID)
+		},
+		func(o, n Condition) bool {
+			return o.Metric.Equal(n.Metric) && o.Op.Equal(n.Op) && o.Error.Equal(n.Error)
+		},
+		func(o, n Condition) bool {
+			return o.Metric.Equal(n.Metric) && o.Op.Equal(n.Op)
+		},
+		func(o, n Condition) bool {
+			return o.Metric.Equal(n.Metric) && o.Error.Equal(n.Error)
+		},
+		func(o, n Condition) bool {
+			return o.Metric.Equal(n.Metric)
+		},
+	}
+
+	matched := make([]int, len(new))
+	for i := range matched {
+		matched[i] = -1
+	}
+	used := make([]bool, len(old))
+	for _, matches := range matchers {
+		for i, n := range new {
+			if matched[i] >= 0 {
+				continue
+			}
+			for j, o := range old {
+				if !used[j] && matches(o, n) {
+					matched[i] = j
+					used[j] = true
+					break
+				}
+			}
+		}
+	}
+	return matched
+}
+
+// conditionChanged returns whether the operator or error of a planned condition differ from the condition in the state.
+// An unknown operator is filled in by SonarCloud, so it does not count as a change.
+func conditionChanged(old, new Condition) bool {
+	if !old.Error.Equal(new.Error) {
+		return true
+	}
+	return !new.Op.IsUnknown() && !new.Op.IsNull() && !old.Op.Equal(new.Op)
+}
+
+// defaultConditionOp returns the operator for a condition on a metric with the given direction.
+// A condition fails when a metric for which higher values are better drops below the error value, or when any other metric exceeds it.
+func defaultConditionOp(direction int) string {
+	if direction > 0 {
+		return "LT"
+	}
+	return "GT"
+}
+
+// filterConditions returns the conditions that match one of the managed conditions, see matchConditions
+func filterConditions(conditions, managed []Condition) []Condition {
+	matched := matchConditions(conditions, managed)
+	used := make([]bool, len(conditions))
+	for _, j := range matched {
+		if j >= 0 {
+			used[j] = true
+		}
+	}
+
+	var result []Condition
+	for j, c := range conditions {
+		if used[j] {
+			result = append(result, c)
 		}
 	}
-	return false
+	return result
 }
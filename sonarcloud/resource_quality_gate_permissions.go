@@ -0,0 +1,351 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+type QualityGatePermissionsResource struct {
+	p *sonarcloudProvider
+}
+
+func NewQualityGatePermissionsResource() resource.Resource {
+	return &QualityGatePermissionsResource{}
+}
+
+func (*QualityGatePermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quality_gate_permissions"
+}
+
+func (d *QualityGatePermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r QualityGatePermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource manages the groups and users that are allowed to edit a single quality gate, without the organization wide `gateadmin` permission." +
+			" Groups and users that are granted access outside of Terraform are removed on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The implicit ID of the resource",
+				Computed:    true,
+			},
+			"gate_id": schema.StringAttribute{
+				Description: "The ID of the quality gate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Description: "The names of the groups that can edit the quality gate.",
+			},
+			"users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Description: "The logins of the users that can edit the quality gate.",
+			},
+		},
+	}
+}
+
+func (r QualityGatePermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityGatePermissions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateName, ok := r.gateName(plan.GateId.ValueString(), &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("gate_id"),
+				"Could not find the Quality Gate",
+				fmt.Sprintf("There is no Quality Gate with the ID '%s'.", plan.GateId.ValueString()),
+			)
+		}
+		return
+	}
+
+	// Compare against the current permissions, so the gate ends up with exactly the planned groups and users
+	current, err := readQualityGatePermissions(r.p, plan.GateId.ValueString(), gateName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_permissions",
+			fmt.Sprintf("The search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	r.applyPermissions(gateName, *current, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := readQualityGatePermissions(r.p, plan.GateId.ValueString(), gateName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_permissions",
+			fmt.Sprintf("The search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r QualityGatePermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state QualityGatePermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateName, ok := r.gateName(state.GateId.ValueString(), &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	// All groups and users are read back, so permissions granted outside of Terraform show up as drift
+	result, err := readQualityGatePermissions(r.p, state.GateId.ValueString(), gateName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_permissions",
+			fmt.Sprintf("The search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r QualityGatePermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from state
+	var state QualityGatePermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityGatePermissions
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateName, ok := r.gateName(state.GateId.ValueString(), &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the Quality Gate",
+				fmt.Sprintf("There is no Quality Gate with the ID '%s'.", state.GateId.ValueString()),
+			)
+		}
+		return
+	}
+
+	// Compare against the current permissions instead of the state, so access granted outside of Terraform is removed as well
+	current, err := readQualityGatePermissions(r.p, state.GateId.ValueString(), gateName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_permissions",
+			fmt.Sprintf("The search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	r.applyPermissions(gateName, *current, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := readQualityGatePermissions(r.p, state.GateId.ValueString(), gateName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_permissions",
+			fmt.Sprintf("The search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r QualityGatePermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state QualityGatePermissions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateName, ok := r.gateName(state.GateId.ValueString(), &resp.Diagnostics)
+	if !ok {
+		// The permissions are gone together with the gate
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	current, err := readQualityGatePermissions(r.p, state.GateId.ValueString(), gateName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the quality_gate_permissions",
+			fmt.Sprintf("The search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	empty := QualityGatePermissions{
+		Groups: types.SetValueMust(types.StringType, []attr.Value{}),
+		Users:  types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+	r.applyPermissions(gateName, *current, empty, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r QualityGatePermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("gate_id"), req, resp)
+}
+
+// gateName looks up the name of the quality gate with the given ID, the permission endpoints refer to gates by name
+func (r QualityGatePermissionsResource) gateName(gateId string, diags *diag.Diagnostics) (string, bool) {
+	request := qualitygates.ListRequest{
+		Organization: r.p.organization,
+	}
+
+	response, err := r.p.client.Qualitygates.List(request)
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Gates",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return "", false
+	}
+
+	gate, ok := findQualityGateByID(response, gateId)
+	return gate.Name.ValueString(), ok
+}
+
+// applyPermissions removes and adds groups and users, so the gate ends up with the wanted permissions
+func (r QualityGatePermissionsResource) applyPermissions(gateName string, haves, wants QualityGatePermissions, diags *diag.Diagnostics) {
+	groupsToAdd, groupsToRemove := diffAttrSets(haves.Groups, wants.Groups)
+	usersToAdd, usersToRemove := diffAttrSets(haves.Users, wants.Users)
+
+	for _, remove := range groupsToRemove {
+		request := qualitygates.RemoveGroupRequest{
+			GateName:     gateName,
+			GroupName:    remove.(types.String).ValueString(),
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.Qualitygates.RemoveGroup(request); err != nil {
+			diags.AddError(
+				"Could not remove the group from the quality gate",
+				fmt.Sprintf("The RemoveGroup request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+	for _, remove := range usersToRemove {
+		request := qualitygates.RemoveUserRequest{
+			GateName:     gateName,
+			Login:        remove.(types.String).ValueString(),
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.Qualitygates.RemoveUser(request); err != nil {
+			diags.AddError(
+				"Could not remove the user from the quality gate",
+				fmt.Sprintf("The RemoveUser request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+	for _, add := range groupsToAdd {
+		request := qualitygates.AddGroupRequest{
+			GateName:     gateName,
+			GroupName:    add.(types.String).ValueString(),
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.Qualitygates.AddGroup(request); err != nil {
+			diags.AddError(
+				"Could not add the group to the quality gate",
+				fmt.Sprintf("The AddGroup request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+	for _, add := range usersToAdd {
+		request := qualitygates.AddUserRequest{
+			GateName:     gateName,
+			Login:        add.(types.String).ValueString(),
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.Qualitygates.AddUser(request); err != nil {
+			diags.AddError(
+				"Could not add the user to the quality gate",
+				fmt.Sprintf("The AddUser request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+}
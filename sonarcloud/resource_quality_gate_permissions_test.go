@@ -0,0 +1,82 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccQualityGatePermissions(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TEST_USER_LOGIN")
+	group := os.Getenv("SONARCLOUD_TEST_GROUP_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUserGroupMember(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityGatePermissionsConfig([]string{group}, []string{login}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sonarcloud_quality_gate_permissions.test", "gate_id", "sonarcloud_quality_gate.test", "gate_id"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_permissions.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_permissions.test", "groups.0", group),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_permissions.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_permissions.test", "users.0", login),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_quality_gate_permissions.test",
+				ImportState:       true,
+				ImportStateIdFunc: qualityGatePermissionsImportId("sonarcloud_quality_gate_permissions.test"),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQualityGatePermissionsConfig([]string{group}, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_permissions.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_permissions.test", "users.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testAccQualityGatePermissionsDestroy,
+	})
+}
+
+func testAccQualityGatePermissionsDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccQualityGatePermissionsConfig(groups, users []string) string {
+	groupsList := "[]"
+	if len(groups) > 0 {
+		groupsList = terraformListString(groups)
+	}
+	usersList := "[]"
+	if len(users) > 0 {
+		usersList = terraformListString(users)
+	}
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "quality_gate_permissions_test"
+}
+
+resource "sonarcloud_quality_gate_permissions" "test" {
+	gate_id = sonarcloud_quality_gate.test.gate_id
+	groups  = %s
+	users   = %s
+}
+`, groupsList, usersList)
+}
+
+func qualityGatePermissionsImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["gate_id"], nil
+	}
+}
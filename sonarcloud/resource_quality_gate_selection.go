@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -45,7 +48,8 @@ func (d *QualityGateSelectionResource) Configure(ctx context.Context, req resour
 
 func (r QualityGateSelectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "This resource selects a quality gate for one or more projects",
+		Description: "This resource selects a quality gate for one or more projects." +
+			" By default, projects that are added to the quality gate outside of Terraform are ignored, enable `exclusive` to deselect them on apply.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The implicit ID of the resource",
@@ -63,6 +67,13 @@ func (r QualityGateSelectionResource) Schema(ctx context.Context, req resource.S
 				Description: "The Keys of the projects which have been selected on the referenced quality gate",
 				Required:    true,
 			},
+			"exclusive": schema.BoolAttribute{
+				Description: "Whether the `project_keys` are the only projects that are selected on the quality gate." +
+					" If enabled, projects that are added to the quality gate outside of Terraform show up as drift and are deselected on apply. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -103,12 +114,7 @@ func (r QualityGateSelectionResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Query for selection
-	searchRequest := qualitygates.SearchRequest{
-		GateId:       plan.GateId.ValueString(),
-		Organization: r.p.organization,
-	}
-
-	res, err := r.p.client.Qualitygates.Search(searchRequest)
+	res, err := searchQualityGateProjects(r.p, plan.GateId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Could not read Quality Gate Selection",
@@ -117,9 +123,28 @@ func (r QualityGateSelectionResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	if plan.Exclusive.ValueBool() {
+		r.deselectOthers(res, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res, err = searchQualityGateProjects(r.p, plan.GateId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not read Quality Gate Selection",
+				fmt.Sprintf("The Search request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
 	if result, ok := findSelection(res, plan.ProjectKeys.Elements()); ok {
+		if plan.Exclusive.ValueBool() {
+			result = allSelected(res)
+		}
 		result.GateId = types.StringValue(plan.GateId.ValueString())
 		result.ID = types.StringValue(plan.GateId.ValueString())
+		result.Exclusive = plan.Exclusive
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -139,11 +164,7 @@ func (r QualityGateSelectionResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	searchRequest := qualitygates.SearchRequest{
-		GateId:       state.GateId.ValueString(),
-		Organization: r.p.organization,
-	}
-	res, err := r.p.client.Qualitygates.Search(searchRequest)
+	res, err := searchQualityGateProjects(r.p, state.GateId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Could not Read the Quality Gate Selection",
@@ -151,9 +172,23 @@ func (r QualityGateSelectionResource) Read(ctx context.Context, req resource.Rea
 		)
 		return
 	}
+
+	// In exclusive mode all selected projects are reported, so missing and additional projects both show up as drift.
+	// After an import there are no project keys yet, so all selected projects are adopted.
+	if state.Exclusive.ValueBool() || state.ProjectKeys.IsNull() {
+		result := allSelected(res)
+		result.GateId = types.StringValue(state.GateId.ValueString())
+		result.ID = types.StringValue(state.GateId.ValueString())
+		result.Exclusive = types.BoolValue(state.Exclusive.ValueBool())
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	if result, ok := findSelection(res, state.ProjectKeys.Elements()); ok {
 		result.GateId = types.StringValue(state.GateId.ValueString())
 		result.ID = types.StringValue(state.GateId.ValueString())
+		result.Exclusive = state.Exclusive
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -208,11 +243,7 @@ func (r QualityGateSelectionResource) Update(ctx context.Context, req resource.U
 		}
 	}
 
-	request := qualitygates.SearchRequest{
-		GateId:       plan.GateId.ValueString(),
-		Organization: r.p.organization,
-	}
-	res, err := r.p.client.Qualitygates.Search(request)
+	res, err := searchQualityGateProjects(r.p, plan.GateId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Could not Read the Quality Gate Selection",
@@ -220,9 +251,30 @@ func (r QualityGateSelectionResource) Update(ctx context.Context, req resource.U
 		)
 		return
 	}
+
+	// Also deselect projects that were added outside of Terraform since the last refresh, or before exclusive was enabled
+	if plan.Exclusive.ValueBool() {
+		r.deselectOthers(res, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res, err = searchQualityGateProjects(r.p, plan.GateId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not Read the Quality Gate Selection",
+				fmt.Sprintf("The Search request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
 	if result, ok := findSelection(res, plan.ProjectKeys.Elements()); ok {
+		if plan.Exclusive.ValueBool() {
+			result = allSelected(res)
+		}
 		result.GateId = types.StringValue(state.GateId.ValueString())
 		result.ID = types.StringValue(state.GateId.ValueString())
+		result.Exclusive = plan.Exclusive
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -260,6 +312,30 @@ func (r QualityGateSelectionResource) Delete(ctx context.Context, req resource.D
 	resp.State.RemoveResource(ctx)
 }
 
+func (r QualityGateSelectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("gate_id"), req, resp)
+}
+
+// deselectOthers deselects all selected projects of the quality gate that are not part of the plan
+func (r QualityGateSelectionResource) deselectOthers(response *qualitygates.SearchResponse, plan Selection, diags *diag.Diagnostics) {
+	_, rem := diffSelection(allSelected(response), plan)
+
+	for _, s := range rem {
+		request := qualitygates.DeselectRequest{
+			Organization: r.p.organization,
+			ProjectKey:   s.(types.String).ValueString(),
+		}
+		err := r.p.client.Qualitygates.Deselect(request)
+		if err != nil {
+			diags.AddError(
+				"Could not Deselect the Quality Gate Selection",
+				fmt.Sprintf("The Deselect request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+}
+
 func diffSelection(state, plan Selection) (sel, rem []attr.Value) {
 	for _, old := range state.ProjectKeys.Elements() {
 		// assume that old is a string
@@ -31,6 +31,22 @@ func TestAccResourceQualityGateSelection(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "gate_id", gate_id),
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "project_keys.0", project_key),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "exclusive", "false"),
+				),
+			},
+			{
+				// Import adopts all projects that are selected on the gate
+				ResourceName:      "sonarcloud_quality_gate_selection.test",
+				ImportState:       true,
+				ImportStateId:     gate_id,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQualityGateSelectionExclusiveConfig(gate_id, project_key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "exclusive", "true"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "project_keys.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "project_keys.0", project_key),
 				),
 			},
 		},
@@ -50,3 +66,13 @@ resource "sonarcloud_quality_gate_selection" "test" {
 }
 	`, gateId, projectKey)
 }
+
+func testAccQualityGateSelectionExclusiveConfig(gateId, projectKey string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate_selection" "test" {
+	gate_id = "%s"
+	project_keys = ["%s"]
+	exclusive = true
+}
+	`, gateId, projectKey)
+}
@@ -2,6 +2,7 @@ package sonarcloud
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -21,6 +22,10 @@ func TestAccResourceQualityGate(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccQualityGateConfig(names[0], def[0], "complexty", testError[0], Op[0]),
+				ExpectError: regexp.MustCompile("Unknown condition metric"),
+			},
 			{
 				Config: testAccQualityGateConfig(names[0], def[0], metrics[0], testError[0], Op[0]),
 				Check: resource.ComposeTestCheckFunc(
@@ -46,6 +51,106 @@ func TestAccResourceQualityGate(t *testing.T) {
 	})
 }
 
+func TestAccResourceQualityGateConditions(t *testing.T) {
+	name := "quality_gate_conditions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityGateConditionsConfig(name, "80"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.#", "2"),
+					// The operator of the coverage condition is filled in from the direction of the metric
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_gate.test", "conditions.*", map[string]string{
+						"metric": "coverage",
+						"error":  "80",
+						"op":     "LT",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_gate.test", "conditions.*", map[string]string{
+						"metric": "duplicated_lines_density",
+						"error":  "3",
+						"op":     "GT",
+					}),
+				),
+			},
+			{
+				// The conditions as read back from SonarCloud must not show up as a change
+				Config:   testAccQualityGateConditionsConfig(name, "80"),
+				PlanOnly: true,
+			},
+			qualityGateImportCheck("sonarcloud_quality_gate.test", name),
+			{
+				Config: testAccQualityGateConditionsConfig(name, "70"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_gate.test", "conditions.*", map[string]string{
+						"metric": "coverage",
+						"error":  "70",
+						"op":     "LT",
+					}),
+				),
+			},
+			{
+				Config: testAccQualityGateDuplicationConfig(name, "5", "LT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_gate.test", "conditions.*", map[string]string{
+						"metric": "duplicated_lines_density",
+						"error":  "5",
+						"op":     "LT",
+					}),
+				),
+			},
+			{
+				// An omitted operator keeps the operator of the existing condition instead of the default of the metric
+				Config: testAccQualityGateDuplicationConfig(name, "10", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_gate.test", "conditions.*", map[string]string{
+						"metric": "duplicated_lines_density",
+						"error":  "10",
+						"op":     "LT",
+					}),
+				),
+			},
+		},
+		CheckDestroy: testAccQualityGateDestroy,
+	})
+}
+
+func TestAccResourceQualityGateCopyFrom(t *testing.T) {
+	name := "quality_gate_copy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQualityGateCopyConfig(name, "does not exist", "new_coverage", "90"),
+				ExpectError: regexp.MustCompile("Could not find the Quality Gate to copy"),
+			},
+			{
+				Config: testAccQualityGateCopyConfig(name, "Sonar way", "new_coverage", "90"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "name", name),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "copy_from", "Sonar way"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.metric", "new_coverage"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.error", "90"),
+				),
+			},
+			{
+				Config: testAccQualityGateCopyConfig(name, "Sonar way", "new_coverage", "70"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "conditions.0.error", "70"),
+				),
+			},
+		},
+		CheckDestroy: testAccQualityGateDestroy,
+	})
+}
+
 func testAccQualityGateDestroy(s *terraform.State) error {
 	return nil
 }
@@ -67,6 +172,59 @@ resource "sonarcloud_quality_gate" "test" {
 
 }
 
+func testAccQualityGateConditionsConfig(name, coverage string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "%s"
+	conditions = [
+		{
+			metric = "coverage"
+			error = "%s"
+		},
+		{
+			metric = "duplicated_lines_density"
+			error = "3"
+			op = "GT"
+		}
+	]
+}
+	`, name, coverage)
+}
+
+func testAccQualityGateDuplicationConfig(name, duplication, op string) string {
+	if op != "" {
+		op = fmt.Sprintf("op = \"%s\"", op)
+	}
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "%s"
+	conditions = [
+		{
+			metric = "duplicated_lines_density"
+			error = "%s"
+			%s
+		}
+	]
+}
+	`, name, duplication, op)
+}
+
+func testAccQualityGateCopyConfig(name, copyFrom, metric, err string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "test" {
+	name = "%s"
+	copy_from = "%s"
+	conditions = [
+		{
+			metric = "%s"
+			error = "%s"
+			op = "LT"
+		}
+	]
+}
+	`, name, copyFrom, metric, err)
+}
+
 func qualityGateImportCheck(resourceName, name string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      resourceName,
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/user_groups"
@@ -48,11 +51,15 @@ func (r UserGroupResource) Schema(ctx context.Context, req resource.SchemaReques
 		Description: "This resource manages a user group.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Computed: true,
+				Computed:    true,
+				Description: "The numeric ID of the user group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the user group.",
+				Description: "The name of the user group. Changing the name renames the group in place.",
 			},
 			"description": schema.StringAttribute{
 				Optional:    true,
@@ -60,7 +67,7 @@ func (r UserGroupResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"default": schema.BoolAttribute{
 				Computed:    true,
-				Description: "Whether the group is the default group or not.",
+				Description: "Whether the group is the default group or not. Use `sonarcloud_default_group` to change the default group.",
 			},
 			"members_count": schema.NumberAttribute{
 				Computed:    true,
@@ -107,7 +114,7 @@ func (r UserGroupResource) Create(ctx context.Context, req resource.CreateReques
 	var result = Group{
 		Default:      types.BoolValue(res.Group.Default),
 		Description:  types.StringValue(res.Group.Description),
-		ID:           types.StringValue(big.NewFloat(res.Group.Id).String()),
+		ID:           types.StringValue(groupID(res.Group.Id)),
 		MembersCount: types.NumberValue(big.NewFloat(res.Group.MembersCount)),
 		Name:         types.StringValue(res.Group.Name),
 	}
@@ -126,9 +133,8 @@ func (r UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Fill in api action struct
-	request := user_groups.SearchRequest{
-		Q: state.Name.ValueString(),
-	}
+	// Note: we search all groups, because the name might have been changed outside of Terraform
+	request := user_groups.SearchRequest{}
 
 	response, err := r.p.client.UserGroups.SearchAll(request)
 	if err != nil {
@@ -139,8 +145,21 @@ func (r UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// Groups are tracked by their ID, only a group imported by name has to be looked up by its name
+	var result Group
+	var ok bool
+	if state.ID.IsNull() || state.ID.ValueString() == "" {
+		result, ok = findGroup(response, state.Name.ValueString())
+	} else {
+		result, ok = findGroupByID(response, state.ID.ValueString())
+		// IDs in exponent notation from earlier provider versions may have lost digits, so fall back to the name
+		if _, err := strconv.ParseInt(state.ID.ValueString(), 10, 64); !ok && err != nil {
+			result, ok = findGroup(response, state.Name.ValueString())
+		}
+	}
+
 	// Check if the resource exists the list of retrieved resources
-	if result, ok := findGroup(response, state.Name.ValueString()); ok {
+	if ok {
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -206,9 +225,14 @@ func (r UserGroupResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Check if the resource exists the list of retrieved resources
-	if result, ok := findGroup(response, plan.Name.ValueString()); ok {
+	if result, ok := findGroupByID(response, state.ID.ValueString()); ok {
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.AddError(
+			"Could not find the user_group",
+			fmt.Sprintf("The user_group with ID '%s' could not be found after the update.", state.ID.ValueString()),
+		)
 	}
 }
 
@@ -238,5 +262,11 @@ func (r UserGroupResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	// The import identifier is either the numeric ID or the name of the group
+	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
+		diags := resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(id, 10))
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	}
 }
@@ -0,0 +1,261 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kauppine/go-sonarcloud/sonarcloud/user_groups"
+)
+
+type UserGroupMembersResource struct {
+	p *sonarcloudProvider
+}
+
+func NewUserGroupMembersResource() resource.Resource {
+	return &UserGroupMembersResource{}
+}
+
+func (*UserGroupMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group_members"
+}
+
+func (d *UserGroupMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*sonarcloudProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sonarcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.p = provider
+}
+
+func (r UserGroupMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource manages all members of a user group. Members that are added outside of Terraform are removed on the next apply." +
+			" **Warning:** do not combine this resource with `sonarcloud_user_group_member` for the same group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"group": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the group whose members are managed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"logins": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The logins of all users that should be a member of the group.",
+			},
+		},
+	}
+}
+
+func (r UserGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan GroupMembers
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compare against the current members, so the group ends up with exactly the planned members
+	request := user_groups.UsersRequest{
+		Name: plan.Group.ValueString(),
+	}
+
+	response, err := r.p.client.UserGroups.UsersAll(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the user_group_members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(groupMemberLogins(response), plan.Logins)
+	r.applyMembers(plan.Group.ValueString(), toAdd, toRemove, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.readMembers(plan.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the user_group_members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r UserGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state GroupMembers
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All members are read back, so members added outside of Terraform show up as drift
+	result, err := r.readMembers(state.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the user_group_members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r UserGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from state
+	var state GroupMembers
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan GroupMembers
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compare against the current members instead of the state, so members added outside of Terraform are removed as well
+	current, err := r.readMembers(state.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the user_group_members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(current.Logins, plan.Logins)
+	r.applyMembers(state.Group.ValueString(), toAdd, toRemove, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.readMembers(state.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the user_group_members",
+			fmt.Sprintf("The UsersAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r UserGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state GroupMembers
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyMembers(state.Group.ValueString(), nil, state.Logins.Elements(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r UserGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group"), req, resp)
+}
+
+// applyMembers removes and adds the given logins to the group
+func (r UserGroupMembersResource) applyMembers(group string, toAdd, toRemove []attr.Value, diags *diag.Diagnostics) {
+	for _, remove := range toRemove {
+		request := user_groups.RemoveUserRequest{
+			Login:        remove.(types.String).ValueString(),
+			Name:         group,
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.UserGroups.RemoveUser(request); err != nil {
+			diags.AddError(
+				"Could not remove the user_group_member",
+				fmt.Sprintf("The RemoveUser request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+	for _, add := range toAdd {
+		request := user_groups.AddUserRequest{
+			Login:        add.(types.String).ValueString(),
+			Name:         group,
+			Organization: r.p.organization,
+		}
+		if err := r.p.client.UserGroups.AddUser(request); err != nil {
+			diags.AddError(
+				"Could not add the user_group_member",
+				fmt.Sprintf("The AddUser request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+}
+
+// readMembers returns all current members of the group
+func (r UserGroupMembersResource) readMembers(group string) (*GroupMembers, error) {
+	request := user_groups.UsersRequest{
+		Name: group,
+	}
+
+	response, err := r.p.client.UserGroups.UsersAll(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupMembers{
+		ID:     types.StringValue(group),
+		Group:  types.StringValue(group),
+		Logins: groupMemberLogins(response),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccUserGroupMembers(t *testing.T) {
+	login := os.Getenv("SONARCLOUD_TEST_USER_LOGIN")
+	group := os.Getenv("SONARCLOUD_TEST_GROUP_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckUserGroupMember(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupMembersConfig(group, []string{login}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test_group_members", "group", group),
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test_group_members", "logins.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test_group_members", "logins.0", login),
+				),
+			},
+			userGroupMembersImportCheck("sonarcloud_user_group_members.test_group_members", group),
+			{
+				Config: testAccUserGroupMembersConfig(group, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test_group_members", "group", group),
+					resource.TestCheckResourceAttr("sonarcloud_user_group_members.test_group_members", "logins.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testAccUserGroupMembersDestroy,
+	})
+}
+
+func testAccUserGroupMembersDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccUserGroupMembersConfig(group string, logins []string) string {
+	loginsList := "[]"
+	if len(logins) > 0 {
+		loginsList = terraformListString(logins)
+	}
+	return fmt.Sprintf(`
+resource "sonarcloud_user_group_members" "test_group_members" {
+	group  = "%s"
+	logins = %s
+}
+`, group, loginsList)
+}
+
+func userGroupMembersImportCheck(resourceName, group string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     group,
+		ImportStateVerify: true,
+	}
+}
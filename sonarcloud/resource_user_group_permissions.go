@@ -102,6 +102,17 @@ func (r UserGroupPermissionsResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r UserGroupPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UserGroupPermissions
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePermissionsScope(config.ProjectKey, config.Permissions)...)
+}
+
 func (r UserGroupPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
@@ -125,8 +136,8 @@ func (r UserGroupPermissionsResource) Create(ctx context.Context, req resource.C
 	for _, elem := range plan.Permissions.Elements() {
 		permission := elem.(types.String).ValueString()
 
+		wg.Add(1)
 		go func() {
-			wg.Add(1)
 			defer wg.Done()
 
 			request := permissions.AddGroupRequest{
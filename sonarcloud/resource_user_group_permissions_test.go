@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -21,6 +22,19 @@ func TestAccUserGroupPermissions(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig("", name, []string{
+					"codeviewer",
+					"scan",
+				}),
+				ExpectError: regexp.MustCompile(`(?s)global permissions:.*"codeviewer"`),
+			},
+			{
+				Config: testAccPermissionConfig(projectKey, name, []string{
+					"provisioning",
+				}),
+				ExpectError: regexp.MustCompile(`(?s)project permissions:.*"provisioning"`),
+			},
 			{
 				Config: testAccPermissionConfig("", name, []string{
 					"provisioning",
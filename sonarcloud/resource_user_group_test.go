@@ -39,6 +39,7 @@ func TestAccUserGroup(t *testing.T) {
 				),
 			},
 			userGroupImportCheck("sonarcloud_user_group.test_group", names[1]),
+			userGroupImportByIDCheck("sonarcloud_user_group.test_group"),
 		},
 		CheckDestroy: testAccUserGroupDestroy,
 	})
@@ -65,3 +66,18 @@ func userGroupImportCheck(resourceName, name string) resource.TestStep {
 		ImportStateVerify: true,
 	}
 }
+
+func userGroupImportByIDCheck(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName: resourceName,
+		ImportState:  true,
+		ImportStateIdFunc: func(s *terraform.State) (string, error) {
+			rs, ok := s.RootModule().Resources[resourceName]
+			if !ok {
+				return "", fmt.Errorf("resource not found: %s", resourceName)
+			}
+			return rs.Primary.ID, nil
+		},
+		ImportStateVerify: true,
+	}
+}
@@ -106,6 +106,17 @@ func (r UserPermissionsResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r UserPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UserPermissions
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePermissionsScope(config.ProjectKey, config.Permissions)...)
+}
+
 func (r UserPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
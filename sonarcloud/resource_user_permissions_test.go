@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -20,6 +21,19 @@ func TestAccUserPermissions(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPermissionConfig("", login, []string{
+					"codeviewer",
+					"scan",
+				}),
+				ExpectError: regexp.MustCompile(`(?s)global permissions:.*"codeviewer"`),
+			},
+			{
+				Config: testAccUserPermissionConfig(projectKey, login, []string{
+					"provisioning",
+				}),
+				ExpectError: regexp.MustCompile(`(?s)project permissions:.*"provisioning"`),
+			},
 			{
 				Config: testAccUserPermissionConfig("", login, []string{
 					"provisioning",
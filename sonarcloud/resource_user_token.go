@@ -3,15 +3,33 @@ package sonarcloud
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/user_tokens"
 )
 
+const (
+	userTokenType            = "USER_TOKEN"
+	projectAnalysisTokenType = "PROJECT_ANALYSIS_TOKEN"
+	globalAnalysisTokenType  = "GLOBAL_ANALYSIS_TOKEN"
+
+	// tokenDateLayout is the format of the expiration date in the Generate request
+	tokenDateLayout = "2006-01-02"
+	// sonarcloudDateTimeLayout is the format in which SonarCloud returns dates, they are stored as RFC 3339
+	sonarcloudDateTimeLayout = "2006-01-02T15:04:05-0700"
+)
+
 type UserTokenResource struct {
 	p *sonarcloudProvider
 }
@@ -48,6 +66,9 @@ func (*UserTokenResource) Schema(ctx context.Context, req resource.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"login": schema.StringAttribute{
 				Required:    true,
@@ -63,15 +84,174 @@ func (*UserTokenResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(userTokenType),
+				Description: fmt.Sprintf("The type of the token. One of `%s`, `%s` or `%s`. Defaults to `%s`.",
+					userTokenType, projectAnalysisTokenType, globalAnalysisTokenType, userTokenType),
+				Validators: []validator.String{
+					stringvalidator.OneOf(userTokenType, projectAnalysisTokenType, globalAnalysisTokenType),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the project the token can analyze. Required for, and only allowed with, tokens of type `" + projectAnalysisTokenType + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "The date on which the token expires, in the format `YYYY-MM-DD`. Conflicts with `rotation_days`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD"),
+					stringvalidator.ConflictsWith(path.MatchRoot("rotation_days")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				Optional: true,
+				Description: "The number of days the token is valid. The token expires this many days after it is created," +
+					" and a replacement is planned once it is about to expire, see `rotate_before`." +
+					" Changing this value does not replace the current token, the new lifetime applies from the next rotation on." +
+					" Conflicts with `expiration_date`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("expiration_date")),
+				},
+			},
+			"rotate_before": schema.Int64Attribute{
+				Optional: true,
+				Description: "The number of days before the token expires from which on a replacement is planned." +
+					" Defaults to planning the replacement once the token has expired. Requires `rotation_days`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AlsoRequires(path.MatchRoot("rotation_days")),
+				},
+			},
 			"token": schema.StringAttribute{
 				Description: "The value of the generated token.",
 				Computed:    true,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time on which the token was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_connection_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time on which the token was last used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date and time on which the token expires. Not set for tokens without expiration date.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+func (r UserTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Token
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are validated once they are known
+	if !config.Type.IsUnknown() && !config.ProjectKey.IsUnknown() {
+		projectToken := config.Type.ValueString() == projectAnalysisTokenType
+		if projectToken && config.ProjectKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_key"),
+				"Missing project_key",
+				fmt.Sprintf("A project_key is required for tokens of type %s.", projectAnalysisTokenType),
+			)
+		}
+		if !projectToken && !config.ProjectKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_key"),
+				"Invalid project_key",
+				fmt.Sprintf("A project_key can only be set for tokens of type %s.", projectAnalysisTokenType),
+			)
+		}
+	}
+
+	if !config.RotationDays.IsNull() && !config.RotationDays.IsUnknown() &&
+		!config.RotateBefore.IsNull() && !config.RotateBefore.IsUnknown() &&
+		config.RotateBefore.ValueInt64() >= config.RotationDays.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_before"),
+			"Invalid rotate_before",
+			fmt.Sprintf("rotate_before (%d) must be less than rotation_days (%d), otherwise the token would be replaced on every apply.",
+				config.RotateBefore.ValueInt64(), config.RotationDays.ValueInt64()),
+		)
+	}
+}
+
+// ModifyPlan plans the replacement of a token that is rotated automatically once it is about to expire
+func (r UserTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state Token
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan Token
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationDays.IsNull() || state.ExpiresAt.IsNull() || state.ExpiresAt.ValueString() == "" {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Could not determine when the user_token expires",
+			fmt.Sprintf("The expiration date '%s' could not be parsed, the token will not be rotated: %+v", state.ExpiresAt.ValueString(), err),
+		)
+		return
+	}
+
+	rotateAt := expiresAt.AddDate(0, 0, -int(plan.RotateBefore.ValueInt64()))
+	if time.Now().Before(rotateAt) {
+		return
+	}
+
+	// The expiration date changes with the new token, which triggers the replacement
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
 func (r UserTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
@@ -90,10 +270,18 @@ func (r UserTokenResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	expirationDate := plan.ExpirationDate.ValueString()
+	if !plan.RotationDays.IsNull() {
+		expirationDate = time.Now().UTC().AddDate(0, 0, int(plan.RotationDays.ValueInt64())).Format(tokenDateLayout)
+	}
+
 	// Fill in api action struct
 	request := user_tokens.GenerateRequest{
-		Login: plan.Login.ValueString(),
-		Name:  plan.Name.ValueString(),
+		ExpirationDate: expirationDate,
+		Login:          plan.Login.ValueString(),
+		Name:           plan.Name.ValueString(),
+		ProjectKey:     plan.ProjectKey.ValueString(),
+		Type:           plan.Type.ValueString(),
 	}
 
 	res, err := r.p.client.UserTokens.Generate(request)
@@ -106,10 +294,18 @@ func (r UserTokenResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	var result = Token{
-		ID:    types.StringValue(res.Name),
-		Login: types.StringValue(res.Login),
-		Name:  types.StringValue(res.Name),
-		Token: types.StringValue(res.Token),
+		ID:                 types.StringValue(res.Name),
+		Login:              types.StringValue(res.Login),
+		Name:               types.StringValue(res.Name),
+		Type:               plan.Type,
+		ProjectKey:         plan.ProjectKey,
+		ExpirationDate:     plan.ExpirationDate,
+		RotationDays:       plan.RotationDays,
+		RotateBefore:       plan.RotateBefore,
+		Token:              types.StringValue(res.Token),
+		CreatedAt:          optionalDateTime(res.CreatedAt),
+		LastConnectionDate: types.StringNull(),
+		ExpiresAt:          optionalDateTime(res.ExpirationDate),
 	}
 	diags = resp.State.Set(ctx, result)
 
@@ -140,9 +336,8 @@ func (r UserTokenResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Check if the resource exists the list of retrieved resources
-	if tokenExists(response, state.Name.ValueString()) {
-		// We cannot read the token value, so just write back the original state
-		diags = resp.State.Set(ctx, state)
+	if result, ok := findUserToken(response, state); ok {
+		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
 		resp.State.RemoveResource(ctx)
@@ -150,7 +345,28 @@ func (r UserTokenResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r UserTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// NOOP, we always need to recreate
+	// Retrieve values from state
+	var state Token
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan Token
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the rotation settings can change without recreating the token, they are not known to SonarCloud
+	state.RotationDays = plan.RotationDays
+	state.RotateBefore = plan.RotateBefore
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r UserTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -28,8 +29,28 @@ func TestAccUserToken(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarcloud_user_token.test_token", "login", login),
 					resource.TestCheckResourceAttr("sonarcloud_user_token.test_token", "name", name),
 					resource.TestCheckResourceAttrSet("sonarcloud_user_token.test_token", "token"),
+					resource.TestCheckResourceAttr("sonarcloud_user_token.test_token", "type", "USER_TOKEN"),
+					resource.TestCheckResourceAttrSet("sonarcloud_user_token.test_token", "created_at"),
+					resource.TestCheckNoResourceAttr("sonarcloud_user_token.test_token", "expires_at"),
 				),
 			},
+			{
+				Config: testAccUserTokenRotationConfig(login, name, 30, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_user_token.test_token", "type", "GLOBAL_ANALYSIS_TOKEN"),
+					resource.TestCheckResourceAttr("sonarcloud_user_token.test_token", "rotation_days", "30"),
+					resource.TestCheckResourceAttr("sonarcloud_user_token.test_token", "rotate_before", "7"),
+					resource.TestCheckResourceAttrSet("sonarcloud_user_token.test_token", "expires_at"),
+				),
+			},
+			{
+				Config:      testAccUserTokenRotationConfig(login, name, 7, 7),
+				ExpectError: regexp.MustCompile("must be less than rotation_days"),
+			},
+			{
+				Config:      testAccUserTokenProjectConfig(login, name),
+				ExpectError: regexp.MustCompile("A project_key is required"),
+			},
 		},
 		CheckDestroy: testAccUserTokenDestroy,
 	})
@@ -47,3 +68,25 @@ resource "sonarcloud_user_token" "test_token" {
 }
 `, login, name)
 }
+
+func testAccUserTokenRotationConfig(login string, name string, rotationDays int, rotateBefore int) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_user_token" "test_token" {
+	login         = "%s"
+	name          = "%s"
+	type          = "GLOBAL_ANALYSIS_TOKEN"
+	rotation_days = %d
+	rotate_before = %d
+}
+`, login, name, rotationDays, rotateBefore)
+}
+
+func testAccUserTokenProjectConfig(login string, name string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_user_token" "test_token" {
+	login = "%s"
+	name  = "%s"
+	type  = "PROJECT_ANALYSIS_TOKEN"
+}
+`, login, name)
+}
@@ -3,13 +3,20 @@ package sonarcloud
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kauppine/go-sonarcloud/sonarcloud/webhooks"
 )
@@ -51,21 +58,34 @@ func (*WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the webhook, this is equal to its key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"key": schema.StringAttribute{
 				Computed:    true,
-				Description: "Key of the webhook.",
+				Description: "Key of the webhook. Changes to the name, url and secret keep the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"project": schema.StringAttribute{
-				Optional:    true,
-				Description: "The key of the project to add the webhook to. If empty, the webhook will be added to the organization.",
+				Optional: true,
+				Description: "The key of the project to add the webhook to. If empty, the webhook will be added to the organization." +
+					" A webhook cannot be moved, so changing this value creates a new webhook with a new key.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"organization": schema.StringAttribute{
-				Required:    true,
-				Description: "The key of the organization that will own the webhook.",
+				Optional: true,
+				Computed: true,
+				Description: "The key of the organization that will own the webhook. Defaults to the organization of the provider." +
+					" A webhook cannot be moved, so changing this value creates a new webhook with a new key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -73,12 +93,39 @@ func (*WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"secret": schema.StringAttribute{
 				Optional:    true,
-				Description: "If provided, secret will be used as the key to generate the HMAC hex (lowercase) digest value in the 'X-Sonar-Webhook-HMAC-SHA256' header.",
+				Description: "If provided, secret will be used as the key to generate the HMAC hex (lowercase) digest value in the 'X-Sonar-Webhook-HMAC-SHA256' header. The secret is stored in the state, consider using `secret_wo` instead.",
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secret_wo")),
+				},
+			},
+			"secret_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Description: "Write-only variant of `secret`, which is never stored in the plan or state. Requires Terraform 1.11 or later." +
+					" Because Terraform cannot detect changes to this value, change `secret_wo_version` to update the secret.",
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The version of `secret_wo`. Changing this value sends the current value of `secret_wo` to SonarCloud.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_wo")),
+				},
+			},
+			"has_secret": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a secret is configured for the webhook. A secret that was removed outside of Terraform is set again on the next apply.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"url": schema.StringAttribute{
 				Required:    true,
-				Description: "The url of the webhook.",
+				Description: "The url of the webhook. Must be an absolute http or https url.",
+				Validators: []validator.String{
+					webhookUrlValidator{},
+				},
 			},
 		},
 	}
@@ -102,12 +149,20 @@ func (r WebhookResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	secret, diags := webhookSecret(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := r.organization(plan)
+
 	// Fill in api action struct
 	request := webhooks.CreateRequest{
 		Name:         plan.Name.ValueString(),
-		Organization: r.p.organization,
+		Organization: organization,
 		Project:      plan.Project.ValueString(),
-		Secret:       plan.Secret.ValueString(),
+		Secret:       secret,
 		Url:          plan.Url.ValueString(),
 	}
 
@@ -122,13 +177,16 @@ func (r WebhookResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	webhook := res.Webhook
 	var result = Webhook{
-		ID:           types.StringValue(webhook.Key),
-		Key:          types.StringValue(webhook.Key),
-		Organization: types.StringValue(r.p.organization),
-		Project:      plan.Project,
-		Name:         types.StringValue(webhook.Name),
-		Url:          types.StringValue(webhook.Url),
-		Secret:       plan.Secret,
+		ID:              types.StringValue(webhook.Key),
+		Key:             types.StringValue(webhook.Key),
+		Organization:    types.StringValue(organization),
+		Project:         plan.Project,
+		Name:            types.StringValue(webhook.Name),
+		Url:             types.StringValue(webhook.Url),
+		Secret:          plan.Secret,
+		SecretWO:        types.StringNull(),
+		SecretWOVersion: plan.SecretWOVersion,
+		HasSecret:       types.BoolValue(secret != ""),
 	}
 	diags = resp.State.Set(ctx, result)
 
@@ -144,9 +202,11 @@ func (r WebhookResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	organization := r.organization(state)
+
 	// Fill in api action struct
 	request := webhooks.ListRequest{
-		Organization: r.p.organization,
+		Organization: organization,
 		Project:      state.Project.ValueString(),
 	}
 
@@ -160,7 +220,10 @@ func (r WebhookResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Check if the resource exists the list of retrieved resources
-	if result, ok := findWebhook(response, state.ID.ValueString(), state.Project.ValueString(), r.p.organization, state.Secret.ValueString()); ok {
+	if result, ok := findWebhook(response, state.ID.ValueString(), state.Project.ValueString(), organization); ok {
+		// The secret cannot be read, so we keep the values from the state
+		result.Secret = state.Secret
+		result.SecretWOVersion = state.SecretWOVersion
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -185,10 +248,16 @@ func (r WebhookResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	secret, diags := webhookSecret(ctx, req.Config, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Fill in api action struct
 	request := webhooks.UpdateRequest{
 		Name:   plan.Name.ValueString(),
-		Secret: plan.Secret.ValueString(),
+		Secret: secret,
 		Url:    plan.Url.ValueString(),
 		// Note: this is an inconsistency in the API naming...
 		Webhook: state.Key.ValueString(),
@@ -204,9 +273,11 @@ func (r WebhookResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// We don't have a return value, so we have to query it again
+	organization := r.organization(state)
+
 	// Fill in api action struct
 	listRequest := webhooks.ListRequest{
-		Organization: r.p.organization,
+		Organization: organization,
 		Project:      state.Project.ValueString(),
 	}
 
@@ -220,9 +291,43 @@ func (r WebhookResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Check if the resource exists the list of retrieved resources
-	if result, ok := findWebhook(response, state.Key.ValueString(), state.Project.ValueString(), r.p.organization, plan.Secret.ValueString()); ok {
+	// The webhook is updated in place, so it must still exist with the same key
+	if result, ok := findWebhook(response, state.Key.ValueString(), state.Project.ValueString(), organization); ok {
+		result.Secret = plan.Secret
+		result.SecretWOVersion = plan.SecretWOVersion
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.Diagnostics.AddError(
+			"Could not find the webhook",
+			fmt.Sprintf("The webhook with key '%s' could not be found after the update.", state.Key.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan plans has_secret from the configuration, so a secret that was removed outside of Terraform is set again
+func (r WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config Webhook
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Secret.IsUnknown() || config.SecretWO.IsUnknown() {
+		return
+	}
+
+	if config.Secret.ValueString() != "" || !config.SecretWO.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("has_secret"), types.BoolValue(true))...)
+	} else if !req.State.Raw.IsNull() && !req.Plan.Raw.Equal(req.State.Raw) {
+		// Whether removing the secret succeeded is only known after the update
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("has_secret"), types.BoolUnknown())...)
 	}
 }
 
@@ -266,8 +371,43 @@ func (r WebhookResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 }
 
-// findWebhook returns the link with the given id, if it exists in the response
-func findWebhook(response *webhooks.ListResponse, key, project_key, organization, secret string) (Webhook, bool) {
+// organization returns the organization of the webhook, which defaults to the organization of the provider
+func (r WebhookResource) organization(webhook Webhook) string {
+	if webhook.Organization.IsNull() || webhook.Organization.IsUnknown() || webhook.Organization.ValueString() == "" {
+		return r.p.organization
+	}
+	return webhook.Organization.ValueString()
+}
+
+// webhookUrlValidator checks that a webhook url is an absolute http or https url
+type webhookUrlValidator struct{}
+
+func (v webhookUrlValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https url"
+}
+
+func (v webhookUrlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webhookUrlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(req.ConfigValue.ValueString())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid webhook url",
+			fmt.Sprintf("The url %q is invalid, it %s.", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+// findWebhook returns the webhook with the given id, if it exists in the response.
+// The secret cannot be read from the API, so only has_secret is filled in.
+func findWebhook(response *webhooks.ListResponse, key, project_key, organization string) (Webhook, bool) {
 	var result Webhook
 	ok := false
 
@@ -283,13 +423,16 @@ func findWebhook(response *webhooks.ListResponse, key, project_key, organization
 	for _, webhook := range response.Webhooks {
 		if webhook.Key == key {
 			result = Webhook{
-				ID:           types.StringValue(webhook.Key),
-				Key:          types.StringValue(webhook.Key),
-				Organization: types.StringValue(organization),
-				Project:      projectKeyVal,
-				Name:         types.StringValue(webhook.Name),
-				Url:          types.StringValue(webhook.Url),
-				Secret:       types.StringValue(secret),
+				ID:              types.StringValue(webhook.Key),
+				Key:             types.StringValue(webhook.Key),
+				Organization:    types.StringValue(organization),
+				Project:         projectKeyVal,
+				Name:            types.StringValue(webhook.Name),
+				Url:             types.StringValue(webhook.Url),
+				Secret:          types.StringNull(),
+				SecretWO:        types.StringNull(),
+				SecretWOVersion: types.Int64Null(),
+				HasSecret:       types.BoolValue(webhook.HasSecret),
 			}
 			ok = true
 			break
@@ -297,3 +440,14 @@ func findWebhook(response *webhooks.ListResponse, key, project_key, organization
 	}
 	return result, ok
 }
+
+// webhookSecret returns the secret to send to SonarCloud. Write-only values are not part of the plan, so secret_wo is read from the config.
+func webhookSecret(ctx context.Context, config tfsdk.Config, plan Webhook) (string, diag.Diagnostics) {
+	if !plan.Secret.IsNull() {
+		return plan.Secret.ValueString(), nil
+	}
+
+	var secret types.String
+	diags := config.GetAttribute(ctx, path.Root("secret_wo"), &secret)
+	return secret.ValueString(), diags
+}
@@ -3,6 +3,7 @@ package sonarcloud
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,23 +14,39 @@ func TestAccWebhook(t *testing.T) {
 	project := os.Getenv("SONARCLOUD_PROJECT_KEY")
 	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
 	secret := "ThisIsNotAVeryGoodSecret..."
+	var key string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectWebhookConfig("test", project, organization, secret, "www.example.com"),
+				ExpectError: regexp.MustCompile("Invalid webhook url"),
+			},
 			{
 				Config: testAccProjectWebhookConfig("test", project, organization, secret, "https://www.example.com"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "name", "test"),
 					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "url", "https://www.example.com"),
 					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "secret", secret),
+					resource.TestCheckResourceAttrWith("sonarcloud_webhook.test", "key", func(value string) error {
+						key = value
+						return nil
+					}),
 				),
 			},
 			webhookImportCheck("sonarcloud_webhook.test", project),
 			{
 				Config: testAccProjectWebhookConfig("test-two", project, organization, "", "https://www.example.com/test"),
 				Check: resource.ComposeTestCheckFunc(
+					// The webhook is updated in place, so the key stays the same
+					resource.TestCheckResourceAttrWith("sonarcloud_webhook.test", "key", func(value string) error {
+						if value != key {
+							return fmt.Errorf("expected the key to stay %s, got %s", key, value)
+						}
+						return nil
+					}),
 					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "name", "test-two"),
 					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "url", "https://www.example.com/test"),
 					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "secret", ""),
@@ -59,6 +76,35 @@ func TestAccWebhook(t *testing.T) {
 	})
 }
 
+func TestAccWebhookWriteOnlySecret(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookWriteOnlySecretConfig("test", organization, "ThisIsNotAVeryGoodSecret...", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "has_secret", "true"),
+					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "secret_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("sonarcloud_webhook.test", "secret_wo"),
+					resource.TestCheckNoResourceAttr("sonarcloud_webhook.test", "secret"),
+				),
+			},
+			{
+				Config: testAccWebhookWriteOnlySecretConfig("test", organization, "ThisIsAnotherSecret...", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "has_secret", "true"),
+					resource.TestCheckResourceAttr("sonarcloud_webhook.test", "secret_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("sonarcloud_webhook.test", "secret_wo"),
+				),
+			},
+		},
+		CheckDestroy: testAccWebhookDestroy,
+	})
+}
+
 func testAccWebhookDestroy(s *terraform.State) error {
 	return nil
 }
@@ -88,6 +134,19 @@ resource "sonarcloud_webhook" "test" {
 	return result
 }
 
+func testAccWebhookWriteOnlySecretConfig(name, organization, secret string, version int) string {
+	result := fmt.Sprintf(`
+resource "sonarcloud_webhook" "test" {
+  name              = "%s"
+  organization      = "%s"
+  secret_wo         = "%s"
+  secret_wo_version = %d
+  url               = "https://www.example.com"
+}
+`, name, organization, secret, version)
+	return result
+}
+
 func webhookImportCheck(resourceName, project string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName: resourceName,